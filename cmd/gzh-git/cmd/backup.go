@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	backupFlags     BulkCommandFlags
+	backupBundle    bool
+	backupBundleDir string
+)
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup <destination> [directory]",
+	Short: "Keep bare mirrors of all repositories as a local backup",
+	Long: `Back up every repository in the specified directory as a bare mirror of its
+origin remote under <destination>.
+
+Mirrors are stored by remote host and path, e.g.
+<destination>/github.com/org/repo.git, so SSH and HTTPS clones of the same
+repository share one mirror. The first run creates the mirrors with
+'git clone --mirror'; later runs only fetch what changed, pruning refs that
+were deleted on the remote.
+
+With --bundle, a git bundle of every new or changed mirror is also written to
+<destination>/bundles (or --bundle-dir) for cold storage. A bundle is a single
+file that can be cloned from with 'git clone <file>'.
+
+Mirrors hold what is on the remote; commits that were never pushed are not
+backed up.`,
+	Example: `  # Mirror every repository in ~/work to /backup/git
+  gz-git backup /backup/git ~/work
+
+  # Preview which mirrors would be created or updated
+  gz-git backup /backup/git ~/work --dry-run
+
+  # Also write bundles for cold storage
+  gz-git backup /backup/git ~/work --bundle
+
+  # Bundles on another disk, at most 2 fetches per host
+  gz-git backup /backup/git ~/work --bundle-dir /mnt/cold/git --host-limit github.com=2`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runBackup,
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	backupCmd.Flags().IntVarP(&backupFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	backupCmd.Flags().IntVarP(&backupFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	backupCmd.Flags().BoolVarP(&backupFlags.DryRun, "dry-run", "n", false, "show which mirrors would be created or updated without doing it")
+	backupCmd.Flags().BoolVarP(&backupFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	backupCmd.Flags().StringVar(&backupFlags.Include, "include", "", "regex pattern to include repositories")
+	backupCmd.Flags().StringVar(&backupFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	backupCmd.Flags().StringVar(&backupFlags.Format, "format", formatDefault, bulkFormatHelp)
+	backupCmd.Flags().BoolVar(&backupFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(backupCmd, &backupFlags)
+	addHostLimitFlag(backupCmd, &backupFlags.HostLimits)
+
+	// Backup-specific flags
+	backupCmd.Flags().BoolVar(&backupBundle, "bundle", false, "also write a git bundle of every new or changed mirror to <destination>/bundles")
+	backupCmd.Flags().StringVar(&backupBundleDir, "bundle-dir", "", "directory for bundles (implies --bundle)")
+}
+
+func runBackup(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	destination := args[0]
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 1 {
+		directory = args[1]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, backupFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(backupFlags.Format); err != nil {
+		return err
+	}
+
+	bundleDir := backupBundleDir
+	if bundleDir == "" && backupBundle {
+		bundleDir = filepath.Join(destination, "bundles")
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(backupFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&backupFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(backupFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Backing up", backupFlags.Format)
+
+	// Build options
+	opts := repository.BulkBackupOptions{
+		Directory:             directory,
+		Destination:           destination,
+		BundleDir:             bundleDir,
+		HostLimits:            hostLimits,
+		Parallel:              backupFlags.Parallel,
+		MaxDepth:              backupFlags.Depth,
+		DryRun:                backupFlags.DryRun,
+		Verbose:               verbose,
+		IncludeSubmodules:     backupFlags.IncludeSubmodules,
+		IncludePattern:        backupFlags.Include,
+		ExcludePattern:        backupFlags.Exclude,
+		Groups:                backupFlags.Groups,
+		ExcludeGroups:         backupFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream results as they complete
+	if backupFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryBackupResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(backupFlags.Format) {
+		if backupFlags.DryRun {
+			fmt.Printf("Backing up repositories in %s to %s (depth: %d) [DRY-RUN]...\n", directory, destination, backupFlags.Depth)
+		} else {
+			fmt.Printf("Backing up repositories in %s to %s (depth: %d)...\n", directory, destination, backupFlags.Depth)
+		}
+	}
+
+	// Execute bulk backup
+	result, err := client.BulkBackup(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk backup failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(backupFlags.Format) {
+		if err := writeBulkReport(backupFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayBackupResults(result, backupFlags.Format)
+	}
+
+	// Return error if there were any failures
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("failed to back up %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayBackupResults displays bulk backup results
+func displayBackupResults(result *repository.BulkBackupResult, format string) {
+	fmt.Println()
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Printf("Mirrors: %s\n", result.Destination)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		// Compact format shows only changes and problems
+		if format == formatCompact && repo.Status == repository.StatusUpToDate {
+			continue
+		}
+		displayBackupRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	fmt.Printf("Summary: %d created, %d updated, %d up to date, %d would create, %d would update, %d no remote, %d shared, %d failed\n",
+		result.Summary[repository.StatusCloned],
+		result.Summary[repository.StatusUpdated],
+		result.Summary[repository.StatusUpToDate],
+		result.Summary[repository.StatusWouldClone],
+		result.Summary[repository.StatusWouldUpdate],
+		result.Summary[repository.StatusNoRemote],
+		result.Summary[repository.StatusSkipped],
+		result.Summary[repository.StatusError])
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayBackupRepoResult displays the backup result of a single repository
+func displayBackupRepoResult(repo repository.RepositoryBackupResult) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusCloned, repository.StatusUpdated:
+		icon = "✓"
+	case repository.StatusUpToDate:
+		icon = "="
+	case repository.StatusWouldClone, repository.StatusWouldUpdate:
+		icon = "→"
+	case repository.StatusNoRemote, repository.StatusSkipped:
+		icon = "⊘"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+	if verbose && repo.BundlePath != "" {
+		fmt.Printf("    Bundle: %s\n", repo.BundlePath)
+	}
+}
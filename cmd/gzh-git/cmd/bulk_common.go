@@ -21,6 +21,15 @@ type BulkCommandFlags struct {
 	Format            string
 	Watch             bool
 	Interval          time.Duration
+	Rescan            bool
+	Groups            []string
+	ExcludeGroups     []string
+	Workspace         string
+	RetryFailed       bool
+	OnlyStatus        []string
+	Retries           int
+	RetryBackoff      time.Duration
+	HostLimits        []string
 }
 
 // addBulkFlags registers common bulk operation flags to a command
@@ -31,9 +40,47 @@ func addBulkFlags(cmd *cobra.Command, flags *BulkCommandFlags) {
 	cmd.Flags().BoolVarP(&flags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
 	cmd.Flags().StringVar(&flags.Include, "include", "", "regex pattern to include repositories")
 	cmd.Flags().StringVar(&flags.Exclude, "exclude", "", "regex pattern to exclude repositories")
-	cmd.Flags().StringVar(&flags.Format, "format", "default", "output format: default, compact")
+	cmd.Flags().StringVar(&flags.Format, "format", formatDefault, bulkFormatHelp)
 	cmd.Flags().BoolVar(&flags.Watch, "watch", false, "continuously run at intervals")
 	cmd.Flags().DurationVar(&flags.Interval, "interval", 5*time.Minute, "interval when watching")
+	cmd.Flags().BoolVar(&flags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(cmd, flags)
+}
+
+// addGroupFlags registers workspace group selection flags to a command
+func addGroupFlags(cmd *cobra.Command, flags *BulkCommandFlags) {
+	cmd.Flags().StringSliceVar(&flags.Groups, "group", nil, "only include repositories in these workspace groups (repeatable)")
+	cmd.Flags().StringSliceVar(&flags.ExcludeGroups, "not-group", nil, "exclude repositories in these workspace groups (repeatable)")
+	cmd.Flags().StringVar(&flags.Workspace, "workspace", "", "workspace manifest defining groups (default: nearest "+repository.DefaultWorkspaceManifest+")")
+}
+
+// addRetryFlags registers flags for retrying transient network failures to a command
+func addRetryFlags(cmd *cobra.Command, flags *BulkCommandFlags) {
+	cmd.Flags().IntVar(&flags.Retries, "retries", 0, "retry transient network failures (timeouts, DNS, HTTP 5xx) up to this many times")
+	cmd.Flags().DurationVar(&flags.RetryBackoff, "retry-backoff", repository.DefaultRetryBackoff, "delay before the first retry; doubles for every further retry")
+}
+
+// addHostLimitFlag registers the per-host concurrency limit flag to a command
+func addHostLimitFlag(cmd *cobra.Command, limits *[]string) {
+	cmd.Flags().StringSliceVar(limits, "host-limit", nil, "limit concurrent operations per remote host on top of --parallel, e.g. git.internal=3 (repeatable)")
+}
+
+// retryPolicy builds the retry policy from the retry flags
+func retryPolicy(flags *BulkCommandFlags) repository.RetryPolicy {
+	return repository.RetryPolicy{
+		MaxRetries: flags.Retries,
+		Backoff:    flags.RetryBackoff,
+	}
+}
+
+// loadBulkWorkspace loads the workspace manifest given by --workspace.
+// Returns nil if no manifest was given; the library then searches for one
+// when groups are selected.
+func loadBulkWorkspace(flags *BulkCommandFlags) (*repository.WorkspaceManifest, error) {
+	if flags.Workspace == "" {
+		return nil, nil
+	}
+	return repository.LoadWorkspaceManifest(flags.Workspace)
 }
 
 // validateBulkDirectory parses and validates the directory argument
@@ -61,6 +108,12 @@ func validateBulkDepth(cmd *cobra.Command, depth int) error {
 	return nil
 }
 
+// newBulkClient creates a client for bulk operations.
+// Repository discovery uses the on-disk scan index; rescan forces a full walk.
+func newBulkClient(rescan bool) repository.Client {
+	return repository.NewClient(repository.WithScanIndex(repository.ScanIndexOptions{Rescan: rescan}))
+}
+
 // createBulkLogger creates a logger for bulk operations
 // Returns a logger if verbose mode is enabled, nil otherwise
 func createBulkLogger(verbose bool) repository.Logger {
@@ -72,9 +125,10 @@ func createBulkLogger(verbose bool) repository.Logger {
 
 // createProgressCallback creates a progress callback function for bulk operations
 // The callback is used to display progress during bulk operations
+// Progress is only shown for the default format
 func createProgressCallback(operationName string, format string, quiet bool) func(int, int, string) {
 	return func(current, total int, repo string) {
-		if !quiet && format != "compact" {
+		if !quiet && format == formatDefault {
 			fmt.Printf("[%d/%d] %s %s...\n", current, total, operationName, repo)
 		}
 	}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// runSelection is the set of repositories selected from a previous run
+type runSelection struct {
+	RunID    string
+	Statuses []string
+	Paths    []string
+}
+
+// addRunJournalFlags registers flags that select repositories from the last recorded run
+func addRunJournalFlags(cmd *cobra.Command, flags *BulkCommandFlags) {
+	cmd.Flags().BoolVar(&flags.RetryFailed, "retry-failed", false, "only process repositories that failed in the last run of this command on this directory")
+	cmd.Flags().StringSliceVar(&flags.OnlyStatus, "only-status", nil, "only process repositories that ended with these statuses in the last run (repeatable)")
+}
+
+// selectFromLastRun resolves --retry-failed and --only-status against the last
+// recorded run of operation on directory. Returns nil if neither flag is set.
+func selectFromLastRun(operation, directory string, flags *BulkCommandFlags) (*runSelection, error) {
+	if !flags.RetryFailed && len(flags.OnlyStatus) == 0 {
+		return nil, nil
+	}
+	if flags.Watch {
+		return nil, fmt.Errorf("--retry-failed and --only-status cannot be used with --watch")
+	}
+
+	statuses := append([]string{}, flags.OnlyStatus...)
+	if flags.RetryFailed {
+		statuses = append(statuses, repository.StatusError)
+	}
+
+	journal, err := repository.NewRunJournal("")
+	if err != nil {
+		return nil, err
+	}
+	run, err := journal.Last(operation, directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runSelection{
+		RunID:    run.ID,
+		Statuses: statuses,
+		Paths:    run.PathsWithStatus(statuses...),
+	}, nil
+}
+
+// applyRunSelection prints which repositories a selection covers.
+// Returns false if the selection is empty and there is nothing to do.
+func applyRunSelection(operation string, selection *runSelection, format string) bool {
+	if len(selection.Paths) == 0 {
+		if humanOutput(format) {
+			fmt.Printf("No repositories with status %s in the last %s run (%s)\n",
+				strings.Join(selection.Statuses, ", "), operation, selection.RunID)
+		}
+		return false
+	}
+
+	if humanOutput(format) {
+		fmt.Printf("Selecting %d repositories with status %s from run %s\n",
+			len(selection.Paths), strings.Join(selection.Statuses, ", "), selection.RunID)
+	}
+	return true
+}
+
+// recordRun saves the result of a bulk run to the run journal so that failed
+// repositories can be retried and the run can be undone. Dry runs are not
+// recorded, and a journal failure only produces a warning. Returns the
+// recorded run, or nil.
+func recordRun(directory string, flags *BulkCommandFlags, report *repository.Report) *repository.Run {
+	if flags.DryRun {
+		return nil
+	}
+
+	run, err := repository.NewRun(directory, report)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record run: %v\n", err)
+		return nil
+	}
+
+	return saveRun(run)
+}
+
+// saveRun saves a run to the run journal; a journal failure only produces a warning.
+// Returns the saved run, or nil.
+func saveRun(run *repository.Run) *repository.Run {
+	journal, err := repository.NewRunJournal("")
+	if err == nil {
+		err = journal.Save(run)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record run: %v\n", err)
+		return nil
+	}
+
+	return run
+}
+
+// displayRetryHint tells the user how to retry failed repositories of a recorded run
+func displayRetryHint(run *repository.Run) {
+	if run == nil {
+		return
+	}
+	if failed := run.Summary[repository.StatusError]; failed > 0 {
+		fmt.Printf("\n%d repositories failed (run %s); retry only those with --retry-failed\n", failed, run.ID)
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// Human-readable output formats for bulk operations.
+// Machine-readable formats are defined in the repository package.
+const (
+	formatDefault = "default"
+	formatCompact = "compact"
+)
+
+// bulkFormatHelp is the --format flag description shared by bulk commands
+const bulkFormatHelp = "output format: default, compact, json, ndjson, csv"
+
+// validateBulkFormat validates the --format flag of a bulk command
+func validateBulkFormat(format string) error {
+	switch format {
+	case formatDefault, formatCompact,
+		repository.OutputFormatJSON, repository.OutputFormatNDJSON, repository.OutputFormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q (must be one of: default, compact, json, ndjson, csv)", format)
+	}
+}
+
+// isMachineFormat returns true for json, ndjson and csv output
+func isMachineFormat(format string) bool {
+	switch format {
+	case repository.OutputFormatJSON, repository.OutputFormatNDJSON, repository.OutputFormatCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// humanOutput returns true if human-readable text should be printed.
+// Machine formats keep stdout free of anything but the requested output.
+func humanOutput(format string) bool {
+	return !quiet && !isMachineFormat(format)
+}
+
+// streamRecord writes a single ndjson record to stdout as soon as a repository completes
+func streamRecord(record repository.Record) {
+	if err := repository.WriteNDJSON(os.Stdout, record); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+}
+
+// writeBulkReport writes the final machine-readable output of a bulk command.
+// ndjson records are streamed while the operation runs, so nothing is left to write.
+func writeBulkReport(format string, report *repository.Report) error {
+	if format == repository.OutputFormatNDJSON {
+		return nil
+	}
+	return repository.WriteReport(os.Stdout, format, report)
+}
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// Progress display modes for --progress
+const (
+	progressModeAuto  = "auto"
+	progressModeTable = "table"
+	progressModeLines = "lines"
+)
+
+const (
+	// progressRedrawInterval is how often the table redraws to update elapsed times
+	progressRedrawInterval = 200 * time.Millisecond
+
+	// progressMaxRows is the number of in-flight repositories listed in the table
+	progressMaxRows = 10
+)
+
+// progressModeFlag is the value of --progress, validated when set
+type progressModeFlag string
+
+func (m *progressModeFlag) String() string { return string(*m) }
+
+func (m *progressModeFlag) Type() string { return "mode" }
+
+func (m *progressModeFlag) Set(value string) error {
+	switch value {
+	case progressModeAuto, progressModeTable, progressModeLines:
+		*m = progressModeFlag(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid progress mode %q: must be auto, table or lines", value)
+	}
+}
+
+// bulkProgress displays the progress of a bulk operation, either as plain
+// "[i/n] operation repo..." lines or as a live table of in-flight repositories.
+// Pass Callback() as ProgressCallback and EventCallback() as ProgressEventCallback;
+// only the one for the selected mode is non-nil.
+type bulkProgress struct {
+	lines func(int, int, string)
+	table *progressTable
+}
+
+// newBulkProgress selects the progress display for an operation. Progress is
+// only shown for the default format. The table is used on a terminal unless
+// --progress says otherwise, or verbose logging would interleave with it.
+func newBulkProgress(operationName, format string) *bulkProgress {
+	if quiet || format != formatDefault {
+		return &bulkProgress{}
+	}
+
+	useTable := false
+	switch string(progressMode) {
+	case progressModeTable:
+		useTable = true
+	case progressModeAuto:
+		useTable = !verbose && isTerminal(os.Stdout) && os.Getenv("TERM") != "dumb"
+	}
+
+	if useTable {
+		return &bulkProgress{table: newProgressTable(os.Stdout, operationName)}
+	}
+	return &bulkProgress{lines: createProgressCallback(operationName, format, quiet)}
+}
+
+// Callback returns the ProgressCallback for line mode, or nil.
+func (p *bulkProgress) Callback() func(int, int, string) {
+	return p.lines
+}
+
+// EventCallback returns the ProgressEventCallback for table mode, or nil.
+func (p *bulkProgress) EventCallback() func(repository.ProgressEvent) {
+	if p.table == nil {
+		return nil
+	}
+	return p.table.handle
+}
+
+// Stop removes the table from the terminal. The table also removes itself
+// once every repository has finished; Stop covers operations that end early.
+func (p *bulkProgress) Stop() {
+	if p.table != nil {
+		p.table.stop()
+	}
+}
+
+// isTerminal reports whether f is a character device such as a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// progressTable redraws a summary of a running bulk operation in place:
+// overall progress, counts by status and the repositories in flight.
+type progressTable struct {
+	out       io.Writer
+	operation string
+	width     int
+
+	mu        sync.Mutex
+	running   bool
+	done      chan struct{}
+	startTime time.Time
+	total     int
+	completed int
+	inFlight  map[string]time.Time
+	counts    map[string]int
+	drawn     int // lines drawn by the last render
+}
+
+func newProgressTable(out io.Writer, operation string) *progressTable {
+	width := 80
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 20 {
+		width = columns
+	}
+	return &progressTable{out: out, operation: operation, width: width}
+}
+
+// handle applies a progress event and redraws the table
+func (t *progressTable) handle(event repository.ProgressEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.running {
+		t.begin()
+	}
+
+	t.total = event.Total
+	t.completed = event.Completed
+	switch event.Kind {
+	case repository.ProgressStarted:
+		t.inFlight[event.Repository] = time.Now()
+	case repository.ProgressFinished:
+		delete(t.inFlight, event.Repository)
+		t.counts[event.Status]++
+	}
+
+	if event.Kind == repository.ProgressFinished && event.Completed >= event.Total {
+		t.end()
+		return
+	}
+	t.render()
+}
+
+// stop removes the table if it is still shown
+func (t *progressTable) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.running {
+		t.end()
+	}
+}
+
+// begin starts a new table and the redraw ticker; t.mu must be held
+func (t *progressTable) begin() {
+	t.running = true
+	t.done = make(chan struct{})
+	t.startTime = time.Now()
+	t.inFlight = make(map[string]time.Time)
+	t.counts = make(map[string]int)
+	t.drawn = 0
+
+	go func(done chan struct{}) {
+		ticker := time.NewTicker(progressRedrawInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.mu.Lock()
+				if t.running {
+					t.render()
+				}
+				t.mu.Unlock()
+			case <-done:
+				return
+			}
+		}
+	}(t.done)
+}
+
+// end clears the table and stops the redraw ticker; t.mu must be held
+func (t *progressTable) end() {
+	t.clear()
+	close(t.done)
+	t.running = false
+}
+
+// clear erases the lines drawn by the last render; t.mu must be held
+func (t *progressTable) clear() {
+	fmt.Fprint(t.out, t.eraseSequence())
+	t.drawn = 0
+}
+
+// eraseSequence returns the escape sequence that moves to the start of the
+// first drawn line and erases to the end of the screen
+func (t *progressTable) eraseSequence() string {
+	if t.drawn == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[%dF\x1b[J", t.drawn)
+}
+
+// render redraws the table; t.mu must be held
+func (t *progressTable) render() {
+	now := time.Now()
+	lines := []string{
+		fmt.Sprintf("%s %d/%d repositories  %s", t.operation, t.completed, t.total, formatElapsed(now.Sub(t.startTime))),
+	}
+
+	if len(t.counts) > 0 {
+		statuses := make([]string, 0, len(t.counts))
+		for status := range t.counts {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+
+		parts := make([]string, len(statuses))
+		for i, status := range statuses {
+			parts[i] = fmt.Sprintf("%s %d", status, t.counts[status])
+		}
+		lines = append(lines, "  "+strings.Join(parts, " · "))
+	}
+
+	// Longest-running repositories first, as those are the ones worth watching
+	repos := make([]string, 0, len(t.inFlight))
+	for repo := range t.inFlight {
+		repos = append(repos, repo)
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		if !t.inFlight[repos[i]].Equal(t.inFlight[repos[j]]) {
+			return t.inFlight[repos[i]].Before(t.inFlight[repos[j]])
+		}
+		return repos[i] < repos[j]
+	})
+
+	nameWidth := t.width - 14
+	for i, repo := range repos {
+		if i == progressMaxRows {
+			lines = append(lines, fmt.Sprintf("  … %d more in flight", len(repos)-progressMaxRows))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  ▸ %-*s %7s",
+			nameWidth, truncateLeft(displayRepository(repo), nameWidth), formatElapsed(now.Sub(t.inFlight[repo]))))
+	}
+
+	// Erase and redraw in a single write to avoid flicker
+	fmt.Fprint(t.out, t.eraseSequence()+strings.Join(lines, "\n")+"\n")
+	t.drawn = len(lines)
+}
+
+// displayRepository shortens a repository path relative to the working directory
+func displayRepository(repo string) string {
+	if !filepath.IsAbs(repo) {
+		return repo
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, repo); err == nil && !strings.HasPrefix(rel, "..") {
+			return rel
+		}
+	}
+	return repo
+}
+
+// truncateLeft shortens s to width runes, keeping the end, which is the most specific part of a path
+func truncateLeft(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width || width < 2 {
+		return s
+	}
+	return "…" + string(runes[len(runes)-width+1:])
+}
+
+// formatElapsed formats a duration as seconds with one decimal, e.g. "3.2s"
+func formatElapsed(d time.Duration) string {
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
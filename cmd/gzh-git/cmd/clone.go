@@ -16,12 +16,19 @@ var (
 	cloneRecursive    bool
 	cloneBare         bool
 	cloneMirror       bool
+	cloneFilter       string
+	cloneSparse       []string
+	cloneFromFile     string
+	cloneParallel     int
+	cloneDryRun       bool
+	cloneFormat       string
+	cloneHostLimits   []string
 )
 
 // cloneCmd represents the clone command
 var cloneCmd = &cobra.Command{
 	Use:   "clone <repository> [directory]",
-	Short: "Clone a repository into a new directory",
+	Short: "Clone one or more repositories into a new directory",
 	Long: `Clone a repository from a remote URL into a local directory.
 
 Supported URL formats:
@@ -30,7 +37,12 @@ Supported URL formats:
   - Git: git://github.com/user/repo.git
   - File: /path/to/repo or file:///path/to/repo
 
-If directory is not specified, the repository name is used.`,
+If directory is not specified, the repository name is used.
+
+With --from-file, every URL listed in the file (one per line, '#' comments
+allowed) is cloned in parallel into [directory] (default: current directory).
+Each repository is cloned into a subdirectory named after the repository, and
+destinations that already contain a repository are skipped.`,
 	Example: `  # Clone a repository
   gz-git clone https://github.com/user/repo.git
 
@@ -47,8 +59,26 @@ If directory is not specified, the repository name is used.`,
   gz-git clone --recursive https://github.com/user/repo.git
 
   # Clone only single branch (faster)
-  gz-git clone --single-branch https://github.com/user/repo.git`,
-	Args: cobra.RangeArgs(1, 2),
+  gz-git clone --single-branch https://github.com/user/repo.git
+
+  # Partial clone without file contents; they are fetched when needed
+  gz-git clone --filter blob:none https://github.com/user/monorepo.git
+
+  # Sparse checkout: only the root files plus services/api and libs/common
+  gz-git clone --sparse services/api,libs/common https://github.com/user/monorepo.git
+
+  # Clone every repository listed in a file into ~/workspace
+  gz-git clone --from-file repos.txt ~/workspace
+
+  # Bulk shallow clone with 10 parallel workers
+  gz-git clone --from-file repos.txt --depth 1 -j 10
+
+  # Bulk partial clone for CI (commits only, trees and files on demand)
+  gz-git clone --from-file repos.txt --filter tree:0
+
+  # Preview a bulk clone
+  gz-git clone --from-file repos.txt --dry-run`,
+	Args: validateCloneArgs,
 	RunE: runClone,
 }
 
@@ -62,11 +92,36 @@ func init() {
 	cloneCmd.Flags().BoolVar(&cloneRecursive, "recursive", false, "initialize submodules in the clone")
 	cloneCmd.Flags().BoolVar(&cloneBare, "bare", false, "create a bare repository")
 	cloneCmd.Flags().BoolVar(&cloneMirror, "mirror", false, "create a mirror repository (all refs)")
+	cloneCmd.Flags().StringVar(&cloneFilter, "filter", "", "partial clone filter: blob:none, blob:limit=<size> or tree:<depth>")
+	cloneCmd.Flags().StringSliceVar(&cloneSparse, "sparse", nil, "check out only these directories (cone-mode sparse checkout, comma-separated)")
+
+	// Bulk clone flags
+	cloneCmd.Flags().StringVar(&cloneFromFile, "from-file", "", "clone all repository URLs listed in a file")
+	cloneCmd.Flags().IntVarP(&cloneParallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel clones (with --from-file)")
+	cloneCmd.Flags().BoolVarP(&cloneDryRun, "dry-run", "n", false, "show what would be cloned without doing it (with --from-file)")
+	cloneCmd.Flags().StringVar(&cloneFormat, "format", formatDefault, bulkFormatHelp+" (with --from-file)")
+	cloneCmd.Flags().StringSliceVar(&cloneHostLimits, "host-limit", nil, "limit concurrent clones per remote host, e.g. git.internal=3 (with --from-file, repeatable)")
+}
+
+// validateCloneArgs accepts <repository> [directory], or only [directory] with --from-file.
+func validateCloneArgs(cmd *cobra.Command, args []string) error {
+	if cloneFromFile != "" {
+		return cobra.MaximumNArgs(1)(cmd, args)
+	}
+	return cobra.RangeArgs(1, 2)(cmd, args)
 }
 
 func runClone(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	if cloneFromFile != "" {
+		return runBulkClone(ctx, args)
+	}
+
+	if cmd.Flags().Changed("parallel") || cloneDryRun || len(cloneHostLimits) > 0 {
+		return fmt.Errorf("--parallel, --dry-run and --host-limit require --from-file")
+	}
+
 	// Parse arguments
 	url := args[0]
 	destination := ""
@@ -93,6 +148,8 @@ func runClone(cmd *cobra.Command, args []string) error {
 		Depth:        cloneDepth,
 		SingleBranch: cloneSingleBranch,
 		Recursive:    cloneRecursive,
+		Filter:       cloneFilter,
+		SparsePaths:  cloneSparse,
 		Bare:         cloneBare,
 		Mirror:       cloneMirror,
 		Quiet:        quiet,
@@ -117,6 +174,119 @@ func runClone(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runBulkClone(ctx context.Context, args []string) error {
+	if cloneBare || cloneMirror || cloneRecursive {
+		return fmt.Errorf("--bare, --mirror and --recursive are not supported with --from-file")
+	}
+
+	// Validate format
+	if err := validateBulkFormat(cloneFormat); err != nil {
+		return err
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(cloneHostLimits)
+	if err != nil {
+		return err
+	}
+
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Create client
+	client := repository.NewClient()
+
+	progress := newBulkProgress("Cloning", cloneFormat)
+
+	// Build options
+	opts := repository.BulkCloneOptions{
+		URLFile:               cloneFromFile,
+		Directory:             directory,
+		Parallel:              cloneParallel,
+		HostLimits:            hostLimits,
+		Branch:                cloneBranch,
+		Depth:                 cloneDepth,
+		SingleBranch:          cloneSingleBranch,
+		Filter:                cloneFilter,
+		SparsePaths:           cloneSparse,
+		DryRun:                cloneDryRun,
+		Verbose:               verbose,
+		Logger:                createBulkLogger(verbose),
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if cloneFormat == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryCloneResult) { streamRecord(r.Record()) }
+	}
+
+	if humanOutput(cloneFormat) {
+		if cloneDryRun {
+			fmt.Printf("Cloning repositories from %s into %s [DRY-RUN]...\n", cloneFromFile, directory)
+		} else {
+			fmt.Printf("Cloning repositories from %s into %s...\n", cloneFromFile, directory)
+		}
+	}
+
+	result, err := client.BulkClone(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk clone failed: %w", err)
+	}
+
+	if isMachineFormat(cloneFormat) {
+		if err := writeBulkReport(cloneFormat, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayCloneResults(result)
+	}
+
+	if count := result.Summary[repository.StatusError]; count > 0 {
+		return fmt.Errorf("%d %s failed to clone", count, repository.PluralSuffix(count, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+func displayCloneResults(result *repository.BulkCloneResult) {
+	fmt.Println()
+	fmt.Println("=== Bulk Clone Results ===")
+	fmt.Printf("Total requested: %d repositories\n", result.TotalRequested)
+	fmt.Printf("Duration:        %s\n", result.Duration.Round(100_000_000)) // Round to 0.1s
+	fmt.Println()
+
+	// Display summary
+	if len(result.Summary) > 0 {
+		fmt.Println("Summary by status:")
+		for status, count := range result.Summary {
+			fmt.Printf("  %s %-15s %d\n", getSyncStatusIcon(status), status+":", count)
+		}
+		fmt.Println()
+	}
+
+	// Display individual results if not compact, otherwise only failures
+	if len(result.Repositories) > 0 {
+		if cloneFormat != "compact" {
+			fmt.Println("Repository details:")
+		}
+		for _, repo := range result.Repositories {
+			if cloneFormat == "compact" && repo.Status != repository.StatusError {
+				continue
+			}
+			fmt.Printf("  %s %-50s %-14s %6s\n", getSyncStatusIcon(repo.Status), repo.RelativePath, repo.Status, repo.Duration.Round(10_000_000))
+			if repo.Error != nil {
+				fmt.Printf("    Error: %v\n", repo.Error)
+			} else if verbose && repo.Message != "" {
+				fmt.Printf("    %s\n", repo.Message)
+			}
+		}
+	}
+}
+
 // extractRepoName extracts the repository name from a URL.
 // Example: https://github.com/user/repo.git -> repo
 func extractRepoName(url string) string {
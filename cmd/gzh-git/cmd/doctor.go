@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// doctorCmd represents the doctor command group
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose and fix common repository problems",
+	Long: `Check repositories for problems that make bulk operations skip them,
+fail or behave unexpectedly, and fix the ones with a safe remedy.
+
+This command provides subcommands for:
+  - Checking every repository in a directory tree (repos)`,
+	Example: `  # Check all repositories under ~/work
+  gz-git doctor repos ~/work
+
+  # Fix what can be fixed safely
+  gz-git doctor repos ~/work --fix`,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
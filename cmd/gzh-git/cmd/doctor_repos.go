@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	doctorReposFlags    BulkCommandFlags
+	doctorReposFix      bool
+	doctorReposOnline   bool
+	doctorReposRemote   string
+	doctorReposStashAge int
+)
+
+// doctorReposCmd represents the doctor repos command
+var doctorReposCmd = &cobra.Command{
+	Use:   "repos [directory]",
+	Short: "Check repositories for common problems",
+	Long: `Check all repositories in the specified directory for common problems.
+
+Checks:
+  rebase-in-progress     a rebase was started but not finished
+  merge-in-progress      a merge was started but not finished
+  detached-head          HEAD is not on a branch
+  no-remote              the remote (--remote) is not configured
+  missing-upstream       the current branch has no upstream, or it is gone
+  diverged               local and upstream branch both have their own commits
+  default-branch         the remote default branch is unknown or outdated, or
+                         differs from the local one (e.g. main vs. master)
+  old-stash              stash entries older than --stash-age days
+  shallow                the repository is a shallow clone
+  stale-remote-branches  remote-tracking branches deleted on the remote (--online)
+
+Only local state is read unless --online is given.
+
+With --fix, issues with a safe remedy are fixed: the upstream is set to the
+remote branch of the same name, the remote default branch is updated and
+stale remote-tracking branches are pruned. Nothing that could lose work is
+changed; the other issues are reported with a hint.
+
+Exits with a non-zero status if any repository still has an issue that is
+not informational.`,
+	Example: `  # Check all repositories in the current directory
+  gz-git doctor repos
+
+  # Also ask the remotes which branches were deleted
+  gz-git doctor repos ~/work --online
+
+  # Fix what can be fixed safely
+  gz-git doctor repos ~/work --fix
+
+  # Open issues of every repository as CSV
+  gz-git doctor repos --format csv > health.csv`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runDoctorRepos,
+}
+
+func init() {
+	doctorCmd.AddCommand(doctorReposCmd)
+
+	// Common bulk operation flags (except dry-run/watch/interval which don't apply)
+	doctorReposCmd.Flags().IntVarP(&doctorReposFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	doctorReposCmd.Flags().IntVarP(&doctorReposFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	doctorReposCmd.Flags().BoolVarP(&doctorReposFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	doctorReposCmd.Flags().StringVar(&doctorReposFlags.Include, "include", "", "regex pattern to include repositories")
+	doctorReposCmd.Flags().StringVar(&doctorReposFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	doctorReposCmd.Flags().StringVar(&doctorReposFlags.Format, "format", formatDefault, bulkFormatHelp)
+	doctorReposCmd.Flags().BoolVar(&doctorReposFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(doctorReposCmd, &doctorReposFlags)
+	addHostLimitFlag(doctorReposCmd, &doctorReposFlags.HostLimits)
+
+	// Doctor-specific flags
+	doctorReposCmd.Flags().BoolVar(&doctorReposFix, "fix", false, "fix issues with a safe remedy")
+	doctorReposCmd.Flags().BoolVar(&doctorReposOnline, "online", false, "also run checks that contact the remote")
+	doctorReposCmd.Flags().StringVar(&doctorReposRemote, "remote", "origin", "remote to check")
+	doctorReposCmd.Flags().IntVar(&doctorReposStashAge, "stash-age", int(repository.DefaultStashMaxAge.Hours()/24), "report stash entries older than this many days")
+}
+
+func runDoctorRepos(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, doctorReposFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(doctorReposFlags.Format); err != nil {
+		return err
+	}
+
+	if doctorReposStashAge < 1 {
+		return fmt.Errorf("--stash-age must be at least 1 day")
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(doctorReposFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&doctorReposFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(doctorReposFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Checking", doctorReposFlags.Format)
+
+	// Build options
+	opts := repository.BulkDoctorOptions{
+		Directory:             directory,
+		Remote:                doctorReposRemote,
+		StashMaxAge:           time.Duration(doctorReposStashAge) * 24 * time.Hour,
+		Online:                doctorReposOnline,
+		Fix:                   doctorReposFix,
+		HostLimits:            hostLimits,
+		Parallel:              doctorReposFlags.Parallel,
+		MaxDepth:              doctorReposFlags.Depth,
+		Verbose:               verbose,
+		IncludeSubmodules:     doctorReposFlags.IncludeSubmodules,
+		IncludePattern:        doctorReposFlags.Include,
+		ExcludePattern:        doctorReposFlags.Exclude,
+		Groups:                doctorReposFlags.Groups,
+		ExcludeGroups:         doctorReposFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream results as they complete
+	if doctorReposFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryDoctorResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(doctorReposFlags.Format) {
+		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, doctorReposFlags.Depth)
+	}
+
+	// Execute bulk doctor
+	result, err := client.BulkDoctor(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("doctor failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(doctorReposFlags.Format) {
+		if err := writeBulkReport(doctorReposFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayDoctorResults(result, doctorReposFlags.Format)
+	}
+
+	// Return error if any repository needs attention
+	unhealthy := result.Summary[repository.StatusUnhealthy] + result.Summary[repository.StatusError]
+	if unhealthy > 0 {
+		return fmt.Errorf("%d %s attention", unhealthy, repository.PluralSuffix(unhealthy, "repository needs", "repositories need"))
+	}
+
+	return nil
+}
+
+// displayDoctorResults displays the results of a bulk health check
+func displayDoctorResults(result *repository.BulkDoctorResult, format string) {
+	fmt.Println()
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		// Compact format hides healthy repositories
+		if format == formatCompact && repo.Status == repository.StatusHealthy {
+			continue
+		}
+		displayDoctorRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	fmt.Printf("Summary: %d healthy, %d fixed, %d unhealthy, %d failed\n",
+		result.Summary[repository.StatusHealthy],
+		result.Summary[repository.StatusFixed],
+		result.Summary[repository.StatusUnhealthy],
+		result.Summary[repository.StatusError])
+	if len(result.Issues) > 0 {
+		checks := make([]string, 0, len(result.Issues))
+		for check := range result.Issues {
+			checks = append(checks, string(check))
+		}
+		sort.Strings(checks)
+		fmt.Print("Issues:")
+		for _, check := range checks {
+			fmt.Printf(" %s=%d", check, result.Issues[repository.HealthCheck(check)])
+		}
+		fmt.Println()
+	}
+	if result.Fixed > 0 {
+		fmt.Printf("Fixed: %d issues\n", result.Fixed)
+	}
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayDoctorRepoResult displays a single repository health check result with its issues
+func displayDoctorRepoResult(repo repository.RepositoryDoctorResult) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusHealthy:
+		icon = "✓"
+	case repository.StatusFixed:
+		icon = "+"
+	case repository.StatusUnhealthy:
+		icon = "⚠"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+
+	for _, issue := range repo.Issues {
+		switch {
+		case issue.Fixed:
+			fmt.Printf("    fixed    %s\n", issue.Message)
+			continue
+		case issue.Severity == repository.SeverityError:
+			fmt.Printf("    error    %s\n", issue.Message)
+		case issue.Severity == repository.SeverityWarning:
+			fmt.Printf("    warning  %s\n", issue.Message)
+		default:
+			fmt.Printf("    info     %s\n", issue.Message)
+		}
+		if issue.FixError != nil {
+			fmt.Printf("             fix failed: %v\n", issue.FixError)
+		}
+		if issue.Hint != "" {
+			hint := issue.Hint
+			if issue.Fixable && !doctorReposFix {
+				hint += " (or --fix)"
+			}
+			fmt.Printf("             → %s\n", hint)
+		}
+	}
+}
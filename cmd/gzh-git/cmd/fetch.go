@@ -81,6 +81,9 @@ func init() {
 
 	// Common bulk operation flags
 	addBulkFlags(fetchCmd, &fetchFlags)
+	addRunJournalFlags(fetchCmd, &fetchFlags)
+	addRetryFlags(fetchCmd, &fetchFlags)
+	addHostLimitFlag(fetchCmd, &fetchFlags.HostLimits)
 
 	// Fetch-specific flags
 	fetchCmd.Flags().BoolVar(&fetchAllRemotes, "all", false, "fetch from all remotes (not just origin)")
@@ -102,27 +105,69 @@ func runFetch(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Validate format
+	if err := validateBulkFormat(fetchFlags.Format); err != nil {
+		return err
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(fetchFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&fetchFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create client
-	client := repository.NewClient()
+	client := newBulkClient(fetchFlags.Rescan)
 
 	// Create logger for verbose mode
 	logger := createBulkLogger(verbose)
 
+	progress := newBulkProgress("Fetching", fetchFlags.Format)
+
 	// Build options
 	opts := repository.BulkFetchOptions{
-		Directory:         directory,
-		Parallel:          fetchFlags.Parallel,
-		MaxDepth:          fetchFlags.Depth,
-		DryRun:            fetchFlags.DryRun,
-		Verbose:           verbose,
-		AllRemotes:        fetchAllRemotes,
-		Prune:             fetchPrune,
-		Tags:              fetchTags,
-		IncludeSubmodules: fetchFlags.IncludeSubmodules,
-		IncludePattern:    fetchFlags.Include,
-		ExcludePattern:    fetchFlags.Exclude,
-		Logger:            logger,
-		ProgressCallback:  createProgressCallback("Fetching", fetchFlags.Format, quiet),
+		Directory:             directory,
+		Parallel:              fetchFlags.Parallel,
+		HostLimits:            hostLimits,
+		MaxDepth:              fetchFlags.Depth,
+		DryRun:                fetchFlags.DryRun,
+		Verbose:               verbose,
+		AllRemotes:            fetchAllRemotes,
+		Prune:                 fetchPrune,
+		Tags:                  fetchTags,
+		Retry:                 retryPolicy(&fetchFlags),
+		IncludeSubmodules:     fetchFlags.IncludeSubmodules,
+		IncludePattern:        fetchFlags.Include,
+		ExcludePattern:        fetchFlags.Exclude,
+		Groups:                fetchFlags.Groups,
+		ExcludeGroups:         fetchFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if fetchFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryFetchResult) { streamRecord(r.Record()) }
+	}
+
+	// Select repositories from the last recorded run
+	selection, err := selectFromLastRun("fetch", directory, &fetchFlags)
+	if err != nil {
+		return err
+	}
+	if selection != nil {
+		if !applyRunSelection("fetch", selection, fetchFlags.Format) {
+			return nil
+		}
+		opts.OnlyRepositories = selection.Paths
 	}
 
 	// Watch mode: continuously fetch at intervals
@@ -131,30 +176,41 @@ func runFetch(cmd *cobra.Command, args []string) error {
 	}
 
 	// One-time fetch
-	if !quiet {
+	if humanOutput(fetchFlags.Format) {
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, fetchFlags.Depth)
 	}
 
 	result, err := client.BulkFetch(ctx, opts)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("bulk fetch failed: %w", err)
 	}
 
+	// Record the run so failed repositories can be retried
+	report := result.Report()
+	run := recordRun(directory, &fetchFlags, report)
+
+	// Machine-readable output
+	if isMachineFormat(fetchFlags.Format) {
+		return writeBulkReport(fetchFlags.Format, report)
+	}
+
 	// Display scan completion message
-	if !quiet && result.TotalScanned == 0 {
+	if humanOutput(fetchFlags.Format) && result.TotalScanned == 0 {
 		fmt.Printf("Scan complete: no repositories found\n")
 	}
 
 	// Display results
-	if !quiet {
+	if humanOutput(fetchFlags.Format) {
 		displayFetchResults(result)
+		displayRetryHint(run)
 	}
 
 	return nil
 }
 
 func runFetchWatch(ctx context.Context, client repository.Client, opts repository.BulkFetchOptions) error {
-	if !quiet {
+	if humanOutput(fetchFlags.Format) {
 		fmt.Printf("Starting watch mode: fetching every %s\n", fetchFlags.Interval)
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", opts.Directory, opts.MaxDepth)
 		fmt.Println("Press Ctrl+C to stop...")
@@ -178,13 +234,13 @@ func runFetchWatch(ctx context.Context, client repository.Client, opts repositor
 	for {
 		select {
 		case <-sigChan:
-			if !quiet {
+			if humanOutput(fetchFlags.Format) {
 				fmt.Println("\nStopping watch...")
 			}
 			return nil
 
 		case <-ticker.C:
-			if !quiet && fetchFlags.Format != "compact" {
+			if humanOutput(fetchFlags.Format) && fetchFlags.Format != "compact" {
 				fmt.Printf("\n[%s] Running scheduled fetch...\n", time.Now().Format("15:04:05"))
 			}
 			if err := executeFetch(ctx, client, opts); err != nil {
@@ -206,8 +262,13 @@ func executeFetch(ctx context.Context, client repository.Client, opts repository
 		return fmt.Errorf("bulk fetch failed: %w", err)
 	}
 
+	// Machine-readable output
+	if isMachineFormat(fetchFlags.Format) {
+		return writeBulkReport(fetchFlags.Format, result.Report())
+	}
+
 	// Display results
-	if !quiet {
+	if humanOutput(fetchFlags.Format) {
 		displayFetchResults(result)
 	}
 
@@ -331,6 +392,10 @@ func displayFetchRepositoryResult(repo repository.RepositoryFetchResult) {
 	if repo.Error != nil && verbose {
 		fmt.Printf("    Error: %v\n", repo.Error)
 	}
+	// Show retries of transient network failures
+	if repo.Attempts > 1 {
+		fmt.Printf("    Attempts: %d (transient failures retried)\n", repo.Attempts)
+	}
 }
 
 // getFetchStatusIconWithContext returns the appropriate icon based on status and actual changes.
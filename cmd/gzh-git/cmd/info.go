@@ -91,6 +91,18 @@ func runInfo(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Remote URL:    %s\n", info.RemoteURL)
 	}
 
+	partial, err := client.PartialClone(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	if partial != nil {
+		if partial.Filter != "" {
+			fmt.Printf("Partial clone: %s (from %s)\n", partial.Filter, partial.Remote)
+		} else {
+			fmt.Printf("Partial clone: from %s\n", partial.Remote)
+		}
+	}
+
 	if info.Upstream != "" {
 		fmt.Printf("Upstream:      %s\n", info.Upstream)
 
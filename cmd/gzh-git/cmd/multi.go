@@ -17,6 +17,14 @@ and perform operations on all of them in parallel.
 Examples:
   gz-git multi switch develop    # Switch all repos to develop branch
   gz-git multi switch main --dry-run  # Preview branch switch
+  gz-git multi exec -- log -1 --oneline  # Run a git command everywhere
+  gz-git multi stash pop --created-by pull  # Restore changes stashed by pull
+  gz-git multi tag create v1.4.0 --push  # Tag a release everywhere
+  gz-git multi cleanup --merged --stale 60d  # Delete merged and stale branches
+  gz-git multi commit -m "chore: bump CI" -- .github  # Commit the same change everywhere
+  gz-git multi remote set-url --from 'git@old:(.*)' --to 'git@new:$1'  # Move clones to a new host
+  gz-git multi maintain -j 2  # Run gc and commit-graph writes, report space reclaimed
+  gz-git multi incoming  # List fetched upstream commits not yet pulled
 
 Use "gz-git multi [command] --help" for more information about a command.`,
 }
@@ -0,0 +1,372 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/branch"
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiCleanupFlags   BulkCommandFlags
+	multiCleanupMerged  bool
+	multiCleanupStale   string
+	multiCleanupBase    string
+	multiCleanupExclude []string
+	multiCleanupRemote  bool
+	multiCleanupForce   bool
+	multiCleanupYes     bool
+)
+
+// multiCleanupCmd represents the multi cleanup command
+var multiCleanupCmd = &cobra.Command{
+	Use:   "cleanup [directory]",
+	Short: "Delete merged or stale branches across repositories",
+	Long: `Find merged or stale branches in all repositories in the specified directory
+and delete them after a single confirmation.
+
+Branches are analyzed in every repository in parallel and listed per repository
+before anything is deleted. The current branch and protected branches
+(main, master, develop, release/*, hotfix/*) are never selected.
+
+Stale branches are usually not merged, so deleting them requires --force.
+With --remote, the upstream branch of each deleted branch is deleted as well.`,
+	Example: `  # Delete merged branches everywhere
+  gz-git multi cleanup --merged
+
+  # Also delete branches without commits for 60 days, and their upstreams
+  gz-git multi cleanup --merged --stale 60d --force --remote
+
+  # Preview what would be deleted
+  gz-git multi cleanup --merged --stale 60d --dry-run
+
+  # Keep personal branches and skip the confirmation
+  gz-git multi cleanup --merged --exclude-branch "wip/*" --yes
+
+  # Machine-readable output (requires --yes unless --dry-run)
+  gz-git multi cleanup --merged --yes --format json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMultiCleanup,
+}
+
+func init() {
+	multiCmd.AddCommand(multiCleanupCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiCleanupCmd.Flags().IntVarP(&multiCleanupFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiCleanupCmd.Flags().IntVarP(&multiCleanupFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiCleanupCmd.Flags().BoolVarP(&multiCleanupFlags.DryRun, "dry-run", "n", false, "show which branches would be deleted without deleting them")
+	multiCleanupCmd.Flags().BoolVarP(&multiCleanupFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiCleanupCmd.Flags().StringVar(&multiCleanupFlags.Include, "include", "", "regex pattern to include repositories")
+	multiCleanupCmd.Flags().StringVar(&multiCleanupFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiCleanupCmd.Flags().StringVar(&multiCleanupFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiCleanupCmd.Flags().BoolVar(&multiCleanupFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiCleanupCmd, &multiCleanupFlags)
+
+	// Cleanup-specific flags
+	multiCleanupCmd.Flags().BoolVar(&multiCleanupMerged, "merged", false, "select branches fully merged into the base branch")
+	multiCleanupCmd.Flags().StringVar(&multiCleanupStale, "stale", "", "select branches without commits for this long (e.g. 60d, 8w, 720h)")
+	multiCleanupCmd.Flags().StringVar(&multiCleanupBase, "base", "", "base branch for merge detection (default: main, master, develop or development)")
+	multiCleanupCmd.Flags().StringSliceVar(&multiCleanupExclude, "exclude-branch", nil, "branch patterns to keep, e.g. \"wip/*\" (repeatable)")
+	multiCleanupCmd.Flags().BoolVar(&multiCleanupRemote, "remote", false, "also delete the upstream branch of each deleted branch")
+	multiCleanupCmd.Flags().BoolVarP(&multiCleanupForce, "force", "f", false, "delete branches even if they are not merged")
+	multiCleanupCmd.Flags().BoolVarP(&multiCleanupYes, "yes", "y", false, "delete without asking for confirmation")
+}
+
+func runMultiCleanup(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiCleanupFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format; a confirmation prompt would corrupt machine-readable output
+	format := multiCleanupFlags.Format
+	if err := validateBulkFormat(format); err != nil {
+		return err
+	}
+	if isMachineFormat(format) && !multiCleanupFlags.DryRun && !multiCleanupYes {
+		return fmt.Errorf("--format %s requires --yes or --dry-run", format)
+	}
+
+	// Validate selection
+	if !multiCleanupMerged && multiCleanupStale == "" {
+		return fmt.Errorf("nothing to clean up: use --merged and/or --stale")
+	}
+	var staleThreshold time.Duration
+	if multiCleanupStale != "" {
+		threshold, err := parseAge(multiCleanupStale)
+		if err != nil {
+			return fmt.Errorf("invalid --stale value: %w", err)
+		}
+		staleThreshold = threshold
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiCleanupFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create services
+	client := newBulkClient(multiCleanupFlags.Rescan)
+	svc := branch.NewCleanupService()
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	// Print header
+	if humanOutput(format) {
+		fmt.Printf("Analyzing branches in %s (depth: %d)...\n", directory, multiCleanupFlags.Depth)
+	}
+
+	// Analyze all repositories
+	progress := newBulkProgress("Analyzing", format)
+	report, err := svc.AnalyzeAll(ctx, branch.BulkCleanupOptions{
+		Directory:         directory,
+		MaxDepth:          multiCleanupFlags.Depth,
+		Parallel:          multiCleanupFlags.Parallel,
+		IncludeSubmodules: multiCleanupFlags.IncludeSubmodules,
+		IncludePattern:    multiCleanupFlags.Include,
+		ExcludePattern:    multiCleanupFlags.Exclude,
+		Groups:            multiCleanupFlags.Groups,
+		ExcludeGroups:     multiCleanupFlags.ExcludeGroups,
+		Workspace:         workspace,
+		Analyze: branch.AnalyzeOptions{
+			IncludeMerged:  multiCleanupMerged,
+			IncludeStale:   multiCleanupStale != "",
+			StaleThreshold: staleThreshold,
+			Exclude:        multiCleanupExclude,
+			BaseBranch:     multiCleanupBase,
+		},
+		Client:                client,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	})
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("branch analysis failed: %w", err)
+	}
+
+	// Display the aggregated report
+	if humanOutput(format) {
+		displayCleanupReport(report)
+	}
+
+	total := report.CountBranches()
+	execute := total > 0 && !multiCleanupFlags.DryRun
+
+	// Stream repositories that have nothing to delete right away; the
+	// others are streamed as their deletions complete
+	if format == repository.OutputFormatNDJSON {
+		for _, repo := range report.Repositories {
+			if !execute || repo.Report == nil || repo.Report.IsEmpty() {
+				streamRecord(repo.Record(nil))
+			}
+		}
+	}
+
+	if !execute {
+		if isMachineFormat(format) {
+			return writeBulkReport(format, report.Report(nil))
+		}
+		if total > 0 && !quiet {
+			fmt.Println("[DRY-RUN] No branches deleted")
+		}
+		return nil
+	}
+
+	// Ask once for all repositories
+	if !multiCleanupYes {
+		repos := report.CountRepositories()
+		prompt := fmt.Sprintf("Delete %d %s in %d %s", total, repository.PluralSuffix(total, "branch", "branches"),
+			repos, repository.PluralSuffix(repos, "repository", "repositories"))
+		if multiCleanupRemote {
+			prompt += " (and their upstream branches)"
+		}
+		if !confirmPrompt(prompt + "?") {
+			fmt.Println("Aborted: no branches deleted")
+			return nil
+		}
+	}
+
+	// Execute deletions; the analysis progress is stopped before the prompt,
+	// so deletions get their own
+	progress = newBulkProgress("Cleaning up", format)
+	executeOpts := branch.BulkExecuteOptions{
+		ExecuteOptions: branch.ExecuteOptions{
+			Force:  multiCleanupForce,
+			Remote: multiCleanupRemote,
+		},
+		Parallel:              multiCleanupFlags.Parallel,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+	if format == repository.OutputFormatNDJSON {
+		analyzed := make(map[string]branch.RepositoryCleanupReport, len(report.Repositories))
+		for _, repo := range report.Repositories {
+			analyzed[repo.Path] = repo
+		}
+		executeOpts.ResultCallback = func(r branch.RepositoryCleanupResult) {
+			streamRecord(analyzed[r.Path].Record(&r))
+		}
+	}
+
+	result, err := svc.ExecuteAll(ctx, report, executeOpts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("branch cleanup failed: %w", err)
+	}
+
+	if isMachineFormat(format) {
+		if err := writeBulkReport(format, report.Report(result)); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayCleanupResult(result)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to delete %d branches", result.Failed)
+	}
+
+	return nil
+}
+
+// parseAge parses a duration that may also be given in days ("60d") or weeks ("8w")
+func parseAge(value string) (time.Duration, error) {
+	units := []struct {
+		suffix string
+		name   string
+		unit   time.Duration
+	}{
+		{"d", "days", 24 * time.Hour},
+		{"w", "weeks", 7 * 24 * time.Hour},
+	}
+	for _, u := range units {
+		if n, ok := strings.CutSuffix(value, u.suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count <= 0 {
+				return 0, fmt.Errorf("%q is not a positive number of %s", value, u.name)
+			}
+			return time.Duration(count) * u.unit, nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("%q must be positive", value)
+	}
+	return d, nil
+}
+
+// confirmPrompt asks a yes/no question on stdin and reports whether the answer was yes
+func confirmPrompt(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println()
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// displayCleanupReport displays the branches selected in each repository
+func displayCleanupReport(report *branch.BulkCleanupReport) {
+	fmt.Println()
+	fmt.Printf("Scanned: %d repositories\n", report.TotalScanned)
+	fmt.Printf("Analyzed: %d repositories\n", len(report.Repositories))
+	fmt.Println()
+
+	for _, repo := range report.Repositories {
+		switch {
+		case repo.Error != nil:
+			fmt.Printf("[✗] %-40s analysis failed\n", repo.RelativePath)
+			fmt.Printf("    Error: %v\n", repo.Error)
+		case repo.Report.IsEmpty():
+			// Clean repositories are only listed in verbose mode
+			if verbose {
+				fmt.Printf("[=] %-40s nothing to clean up\n", repo.RelativePath)
+			}
+		default:
+			fmt.Printf("[●] %-40s %d %s to delete\n", repo.RelativePath, repo.Report.CountBranches(),
+				pluralize(repo.Report.CountBranches(), "branch", "branches"))
+			displayCleanupBranches("merged", repo.Report.Merged)
+			displayCleanupBranches("stale", repo.Report.Stale)
+			displayCleanupBranches("orphaned", repo.Report.Orphaned)
+		}
+	}
+
+	fmt.Println()
+	branches, repos := report.CountBranches(), report.CountRepositories()
+	fmt.Printf("Found: %d %s to delete in %d %s (analysis took %s)\n",
+		branches, repository.PluralSuffix(branches, "branch", "branches"),
+		repos, repository.PluralSuffix(repos, "repository", "repositories"), report.Duration.Round(time.Millisecond))
+}
+
+// displayCleanupBranches lists the branches selected for one reason
+func displayCleanupBranches(reason string, branches []*branch.Branch) {
+	for _, b := range branches {
+		if b.Upstream != "" {
+			fmt.Printf("    %-9s %s (upstream: %s)\n", reason, b.Name, b.Upstream)
+		} else {
+			fmt.Printf("    %-9s %s\n", reason, b.Name)
+		}
+	}
+}
+
+// displayCleanupResult displays the outcome of each deletion
+func displayCleanupResult(result *branch.BulkCleanupResult) {
+	fmt.Println()
+	for _, repo := range result.Repositories {
+		for _, b := range repo.Branches {
+			switch {
+			case b.Error != nil && b.Deleted:
+				fmt.Printf("[⚠] %-40s %s deleted locally, upstream not deleted\n", repo.RelativePath, b.Name)
+				fmt.Printf("    Error: %v\n", b.Error)
+			case b.Error != nil:
+				fmt.Printf("[✗] %-40s %s not deleted\n", repo.RelativePath, b.Name)
+				fmt.Printf("    Error: %v\n", b.Error)
+			case b.Remote:
+				fmt.Printf("[✓] %-40s %s deleted (with upstream)\n", repo.RelativePath, b.Name)
+			default:
+				fmt.Printf("[✓] %-40s %s deleted\n", repo.RelativePath, b.Name)
+			}
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d deleted, %d failed\n", result.Deleted, result.Failed)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/commit"
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiCommitFlags      BulkCommandFlags
+	multiCommitMessage    string
+	multiCommitAuto       bool
+	multiCommitTemplate   string
+	multiCommitNoValidate bool
+)
+
+// multiCommitCmd represents the multi commit command
+var multiCommitCmd = &cobra.Command{
+	Use:   "commit [directory] [-- <pathspec>...]",
+	Short: "Commit the same change in all repositories",
+	Long: `Stage and commit changes in all repositories in the specified directory.
+
+Use this for cross-repository changes such as license headers or CI config bumps.
+Paths after "--" select what is staged (default: all changes). Repositories with
+no changes in those paths are skipped, and so are repositories that already have
+other changes staged, so unrelated work is never committed.
+
+The message given with -m is validated against the commit template once, before
+any repository is touched. With --auto, a message is generated and validated for
+each repository instead.`,
+	Example: `  # Commit a license header update everywhere
+  gz-git multi commit -m "chore: update license headers" -- LICENSE "*.go"
+
+  # Commit a CI config bump in the backend group
+  gz-git multi commit --group backend -m "ci: bump go version" -- .github/workflows
+
+  # Generate a message per repository
+  gz-git multi commit --auto
+
+  # Report the resulting commit hashes as JSON
+  gz-git multi commit -m "ci: bump go version" --format json -- .github/workflows
+
+  # Preview which repositories would get a commit
+  gz-git multi commit -m "chore: update license headers" --dry-run -- LICENSE`,
+	RunE: runMultiCommit,
+}
+
+func init() {
+	multiCmd.AddCommand(multiCommitCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiCommitCmd.Flags().IntVarP(&multiCommitFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiCommitCmd.Flags().IntVarP(&multiCommitFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiCommitCmd.Flags().BoolVarP(&multiCommitFlags.DryRun, "dry-run", "n", false, "show what would be committed without committing")
+	multiCommitCmd.Flags().BoolVarP(&multiCommitFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiCommitCmd.Flags().StringVar(&multiCommitFlags.Include, "include", "", "regex pattern to include repositories")
+	multiCommitCmd.Flags().StringVar(&multiCommitFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiCommitCmd.Flags().StringVar(&multiCommitFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiCommitCmd.Flags().BoolVar(&multiCommitFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiCommitCmd, &multiCommitFlags)
+
+	// Commit-specific flags
+	multiCommitCmd.Flags().StringVarP(&multiCommitMessage, "message", "m", "", "commit message shared by all repositories")
+	multiCommitCmd.Flags().BoolVar(&multiCommitAuto, "auto", false, "generate a commit message per repository")
+	multiCommitCmd.Flags().StringVar(&multiCommitTemplate, "template", "conventional", "template to generate and validate messages (conventional|semantic)")
+	multiCommitCmd.Flags().BoolVar(&multiCommitNoValidate, "no-validate", false, "do not validate messages against the template")
+}
+
+// splitCommitArgs splits positional arguments into the directory and the pathspecs.
+// Arguments before "--" are the optional directory; arguments after it are pathspecs.
+func splitCommitArgs(cmd *cobra.Command, args []string) (string, []string, error) {
+	positional, paths := args, []string(nil)
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		positional, paths = args[:dash], args[dash:]
+	}
+
+	if len(positional) > 1 {
+		return "", nil, fmt.Errorf("expected at most one directory, got %d arguments (put pathspecs after --)", len(positional))
+	}
+
+	directory := "."
+	if len(positional) == 1 {
+		directory = positional[0]
+	}
+	return directory, paths, nil
+}
+
+func runMultiCommit(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	directory, paths, err := splitCommitArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiCommitFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiCommitFlags.Format); err != nil {
+		return err
+	}
+
+	// Validate message source
+	if multiCommitAuto == (multiCommitMessage != "") {
+		return fmt.Errorf("use either -m/--message or --auto")
+	}
+
+	// Load template
+	tmpl, err := commit.NewTemplateManager().Load(ctx, multiCommitTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to load template: %w", err)
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiCommitFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiCommitFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	// Print header
+	if humanOutput(multiCommitFlags.Format) {
+		if multiCommitFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiCommitFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiCommitFlags.Depth)
+		}
+	}
+
+	progress := newBulkProgress("Committing in", multiCommitFlags.Format)
+
+	// Build options
+	opts := commit.BulkCommitOptions{
+		Directory:             directory,
+		MaxDepth:              multiCommitFlags.Depth,
+		Parallel:              multiCommitFlags.Parallel,
+		IncludeSubmodules:     multiCommitFlags.IncludeSubmodules,
+		IncludePattern:        multiCommitFlags.Include,
+		ExcludePattern:        multiCommitFlags.Exclude,
+		Groups:                multiCommitFlags.Groups,
+		ExcludeGroups:         multiCommitFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Message:               multiCommitMessage,
+		Auto:                  multiCommitAuto,
+		Template:              tmpl,
+		SkipValidation:        multiCommitNoValidate,
+		Paths:                 paths,
+		DryRun:                multiCommitFlags.DryRun,
+		Client:                client,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream results as they complete
+	if multiCommitFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r commit.RepositoryCommitResult) { streamRecord(r.Record()) }
+	}
+
+	// Execute bulk commit
+	result, err := commit.NewBulkCommitter().Commit(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk commit failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiCommitFlags.Format) {
+		if err := writeBulkReport(multiCommitFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayCommitResults(result)
+	}
+
+	// Return error if there were any failures
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("commit failed in %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayCommitResults displays the results of a bulk commit operation
+func displayCommitResults(result *commit.BulkCommitResult) {
+	fmt.Println()
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Warnings about the shared message are shown once
+	if result.Validation != nil && len(result.Validation.Warnings) > 0 {
+		fmt.Print(commit.FormatWarnings(result.Validation))
+		fmt.Println()
+	}
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		// Clean repositories are only listed in verbose mode
+		if repo.Status == repository.StatusClean && !verbose {
+			continue
+		}
+		displayCommitRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	displayCommitSummary(result)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayCommitRepoResult displays a single repository commit result
+func displayCommitRepoResult(repo commit.RepositoryCommitResult) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusCommitted:
+		icon = "✓"
+	case repository.StatusWouldCommit:
+		icon = "~"
+	case repository.StatusClean:
+		icon = "="
+	case repository.StatusSkipped:
+		icon = "⊘"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+
+	// Generated messages differ per repository, so show them
+	if multiCommitAuto && repo.CommitMessage != "" {
+		fmt.Printf("    Message: %s\n", strings.SplitN(repo.CommitMessage, "\n", 2)[0])
+	}
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+}
+
+// displayCommitSummary displays the summary of bulk commit results
+func displayCommitSummary(result *commit.BulkCommitResult) {
+	labels := []struct {
+		status string
+		label  string
+	}{
+		{repository.StatusCommitted, "committed"},
+		{repository.StatusWouldCommit, "would-commit"},
+		{repository.StatusClean, "clean"},
+		{repository.StatusSkipped, "skipped"},
+		{repository.StatusError, "errors"},
+	}
+
+	parts := []string{}
+	for _, l := range labels {
+		if count := result.Summary[l.status]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, l.label))
+		}
+	}
+
+	if len(parts) == 0 {
+		fmt.Println("Summary: no repositories")
+		return
+	}
+	fmt.Printf("Summary: %s\n", strings.Join(parts, ", "))
+}
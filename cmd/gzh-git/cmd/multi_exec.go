@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var multiExecFlags BulkCommandFlags
+
+// multiExecCmd represents the multi exec command
+var multiExecCmd = &cobra.Command{
+	Use:   "exec [directory] -- <git-command> [args...]",
+	Short: "Run a git command in all repositories",
+	Long: `Run a git command in all repositories in the specified directory.
+
+The command is given without the leading "git", after a "--" separator.
+It runs in every discovered repository in parallel, using the same discovery
+and filters as the other bulk commands.
+
+Output is grouped per repository. Repositories that produce identical output
+are collapsed into a single block, so commands that print the same thing
+everywhere are shown only once.
+
+For safety, arguments are validated like every other gz-git git call:
+the command must start with a subcommand (no global options such as -c or -C),
+shell metacharacters are rejected, and only known-safe long options are allowed.`,
+	Example: `  # Show the last commit of every repository
+  gz-git multi exec -- log -1 --oneline
+
+  # Show the current branch of repositories under ~/work
+  gz-git multi exec ~/work -- branch --show-current
+
+  # Only repositories in the backend group, as JSON
+  gz-git multi exec --group backend --format json -- status --short
+
+  # List the repositories the command would run in
+  gz-git multi exec --dry-run -- gc`,
+	RunE: runMultiExec,
+}
+
+func init() {
+	multiCmd.AddCommand(multiExecCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiExecCmd.Flags().IntVarP(&multiExecFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiExecCmd.Flags().IntVarP(&multiExecFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiExecCmd.Flags().BoolVarP(&multiExecFlags.DryRun, "dry-run", "n", false, "show where the command would run without running it")
+	multiExecCmd.Flags().BoolVarP(&multiExecFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiExecCmd.Flags().StringVar(&multiExecFlags.Include, "include", "", "regex pattern to include repositories")
+	multiExecCmd.Flags().StringVar(&multiExecFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiExecCmd.Flags().StringVar(&multiExecFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiExecCmd.Flags().BoolVar(&multiExecFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiExecCmd, &multiExecFlags)
+	addRunJournalFlags(multiExecCmd, &multiExecFlags)
+}
+
+// splitExecArgs splits positional arguments into the directory and the git command.
+// Arguments before "--" are the optional directory; arguments after it are the command.
+// Without "--", all arguments are the command.
+func splitExecArgs(cmd *cobra.Command, args []string) (string, []string, error) {
+	directory := "."
+	command := args
+
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		if dash > 1 {
+			return "", nil, fmt.Errorf("expected at most one directory before --, got %d arguments", dash)
+		}
+		if dash == 1 {
+			directory = args[0]
+		}
+		command = args[dash:]
+	}
+
+	if len(command) == 0 {
+		return "", nil, fmt.Errorf("git command is required (e.g. gz-git multi exec -- status --short)")
+	}
+
+	return directory, command, nil
+}
+
+func runMultiExec(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	directory, command, err := splitExecArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiExecFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiExecFlags.Format); err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiExecFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiExecFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Running in", multiExecFlags.Format)
+
+	// Build options
+	opts := repository.BulkExecOptions{
+		Directory:             directory,
+		Args:                  command,
+		Parallel:              multiExecFlags.Parallel,
+		MaxDepth:              multiExecFlags.Depth,
+		DryRun:                multiExecFlags.DryRun,
+		Verbose:               verbose,
+		IncludeSubmodules:     multiExecFlags.IncludeSubmodules,
+		IncludePattern:        multiExecFlags.Include,
+		ExcludePattern:        multiExecFlags.Exclude,
+		Groups:                multiExecFlags.Groups,
+		ExcludeGroups:         multiExecFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if multiExecFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryExecResult) { streamRecord(r.Record()) }
+	}
+
+	// Select repositories from the last recorded run
+	selection, err := selectFromLastRun("exec", directory, &multiExecFlags)
+	if err != nil {
+		return err
+	}
+	if selection != nil {
+		if !applyRunSelection("exec", selection, multiExecFlags.Format) {
+			return nil
+		}
+		opts.OnlyRepositories = selection.Paths
+	}
+
+	// Print header
+	if humanOutput(multiExecFlags.Format) {
+		if multiExecFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiExecFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiExecFlags.Depth)
+		}
+	}
+
+	// Execute bulk exec
+	result, err := client.BulkExec(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk exec failed: %w", err)
+	}
+
+	// Record the run so failed repositories can be retried
+	report := result.Report()
+	run := recordRun(directory, &multiExecFlags, report)
+
+	// Display results
+	if isMachineFormat(multiExecFlags.Format) {
+		if err := writeBulkReport(multiExecFlags.Format, report); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayExecResults(result, multiExecFlags.Format)
+		displayRetryHint(run)
+	}
+
+	// Return error if there were any failures
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("command failed in %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayExecResults displays the results of a bulk exec operation,
+// collapsing repositories with identical output
+func displayExecResults(result *repository.BulkExecResult, format string) {
+	fmt.Println()
+	fmt.Printf("Command: git %s\n", strings.Join(result.Args, " "))
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+
+	for _, group := range result.GroupOutputs() {
+		// Compact format only shows failures
+		if format == formatCompact && group.Status != repository.StatusError {
+			continue
+		}
+		displayExecGroup(group)
+	}
+
+	// Display summary
+	fmt.Println()
+	displayExecSummary(result)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayExecGroup displays one block of repositories that share the same output
+func displayExecGroup(group repository.ExecOutputGroup) {
+	var icon string
+	switch group.Status {
+	case repository.StatusSuccess:
+		icon = "✓"
+	case repository.StatusWouldRun:
+		icon = "~"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Println()
+	if len(group.Repositories) == 1 {
+		fmt.Printf("[%s] %s", icon, group.Repositories[0])
+	} else {
+		fmt.Printf("[%s] %d repositories: %s", icon, len(group.Repositories), strings.Join(group.Repositories, ", "))
+	}
+	if group.Status == repository.StatusError && group.ExitCode > 0 {
+		fmt.Printf(" (exit code %d)", group.ExitCode)
+	}
+	fmt.Println()
+
+	for _, output := range []string{group.Stdout, group.Stderr} {
+		output = strings.TrimRight(output, "\n")
+		if output == "" {
+			continue
+		}
+		for _, line := range strings.Split(output, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+}
+
+// displayExecSummary displays the summary of bulk exec results
+func displayExecSummary(result *repository.BulkExecResult) {
+	parts := []string{}
+
+	if count := result.Summary[repository.StatusSuccess]; count > 0 {
+		parts = append(parts, fmt.Sprintf("%d succeeded", count))
+	}
+	if count := result.Summary[repository.StatusWouldRun]; count > 0 {
+		parts = append(parts, fmt.Sprintf("%d would-run", count))
+	}
+	if count := result.Summary[repository.StatusError]; count > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", count))
+	}
+
+	if len(parts) == 0 {
+		fmt.Println("Summary: no repositories")
+		return
+	}
+	fmt.Printf("Summary: %s\n", strings.Join(parts, ", "))
+}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiIncomingFlags      BulkCommandFlags
+	multiIncomingMaxCommits int
+)
+
+// maxIncomingFiles is the number of touched files shown per commit unless --verbose
+const maxIncomingFiles = 3
+
+// multiIncomingCmd represents the multi incoming command
+var multiIncomingCmd = &cobra.Command{
+	Use:   "incoming [directory]",
+	Short: "List upstream commits not yet pulled, across repositories",
+	Long: `List, for every repository in the specified directory, the commits on the
+upstream of the current branch that are not yet in the local branch: hash,
+author, age, subject and the files each commit touched.
+
+Only remote-tracking branches are read, so the digest shows what the last
+fetch brought in; run "gz-git fetch" first for current results. Nothing is
+changed.`,
+	Example: `  # What changed upstream since the last pull?
+  gz-git fetch ~/work && gz-git multi incoming ~/work
+
+  # Only repositories with incoming commits, at most 5 commits each
+  gz-git multi incoming --format compact --max-commits 5
+
+  # Digest as JSON, with the touched files of every commit
+  gz-git multi incoming --format json > incoming.json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMultiIncoming,
+}
+
+func init() {
+	multiCmd.AddCommand(multiIncomingCmd)
+
+	// Common bulk operation flags (except dry-run/watch/interval which don't apply)
+	multiIncomingCmd.Flags().IntVarP(&multiIncomingFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiIncomingCmd.Flags().IntVarP(&multiIncomingFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiIncomingCmd.Flags().BoolVarP(&multiIncomingFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiIncomingCmd.Flags().StringVar(&multiIncomingFlags.Include, "include", "", "regex pattern to include repositories")
+	multiIncomingCmd.Flags().StringVar(&multiIncomingFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiIncomingCmd.Flags().StringVar(&multiIncomingFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiIncomingCmd.Flags().BoolVar(&multiIncomingFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiIncomingCmd, &multiIncomingFlags)
+
+	// Incoming-specific flags
+	multiIncomingCmd.Flags().IntVar(&multiIncomingMaxCommits, "max-commits", repository.DefaultIncomingMaxCommits, "maximum number of commits listed per repository")
+}
+
+func runMultiIncoming(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiIncomingFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiIncomingFlags.Format); err != nil {
+		return err
+	}
+
+	if multiIncomingMaxCommits < 1 {
+		return fmt.Errorf("--max-commits must be at least 1")
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiIncomingFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiIncomingFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Reading", multiIncomingFlags.Format)
+
+	// Build options
+	opts := repository.BulkIncomingOptions{
+		Directory:             directory,
+		MaxCommits:            multiIncomingMaxCommits,
+		Parallel:              multiIncomingFlags.Parallel,
+		MaxDepth:              multiIncomingFlags.Depth,
+		Verbose:               verbose,
+		IncludeSubmodules:     multiIncomingFlags.IncludeSubmodules,
+		IncludePattern:        multiIncomingFlags.Include,
+		ExcludePattern:        multiIncomingFlags.Exclude,
+		Groups:                multiIncomingFlags.Groups,
+		ExcludeGroups:         multiIncomingFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream results as they complete
+	if multiIncomingFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryIncomingResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(multiIncomingFlags.Format) {
+		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiIncomingFlags.Depth)
+	}
+
+	// Execute bulk incoming
+	result, err := client.BulkIncoming(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk incoming failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiIncomingFlags.Format) {
+		if err := writeBulkReport(multiIncomingFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayIncomingResults(result, multiIncomingFlags.Format)
+	}
+
+	// Return error if there were any failures
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("failed to read %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayIncomingResults displays the incoming commits of all repositories as one digest
+func displayIncomingResults(result *repository.BulkIncomingResult, format string) {
+	fmt.Println()
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	now := time.Now()
+	for _, repo := range result.Repositories {
+		// Compact format hides repositories without incoming commits
+		if format == formatCompact && repo.Status != repository.StatusIncoming && repo.Status != repository.StatusError {
+			continue
+		}
+		displayIncomingRepoResult(repo, now)
+	}
+
+	// Display summary
+	fmt.Println()
+	fmt.Printf("Summary: %d with incoming commits (%d commits), %d up to date, %d no upstream, %d skipped, %d failed\n",
+		result.Summary[repository.StatusIncoming],
+		result.TotalCommits,
+		result.Summary[repository.StatusUpToDate],
+		result.Summary[repository.StatusNoUpstream],
+		result.Summary[repository.StatusSkipped],
+		result.Summary[repository.StatusError])
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayIncomingRepoResult displays the incoming commits of a single repository
+func displayIncomingRepoResult(repo repository.RepositoryIncomingResult, now time.Time) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusIncoming:
+		icon = "↓"
+	case repository.StatusUpToDate:
+		icon = "="
+	case repository.StatusNoUpstream, repository.StatusSkipped:
+		icon = "⚠"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+
+	for _, commit := range repo.Commits {
+		hash := commit.Hash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		fmt.Printf("    %s  %-50s %s, %s\n", hash, truncateSubject(commit.Subject, 50), commit.Author, formatAge(now.Sub(commit.Date)))
+
+		files := commit.Files
+		more := 0
+		if !verbose && len(files) > maxIncomingFiles {
+			more = len(files) - maxIncomingFiles
+			files = files[:maxIncomingFiles]
+		}
+		if len(files) > 0 {
+			line := strings.Join(files, ", ")
+			if more > 0 {
+				line += fmt.Sprintf(" (+%d more)", more)
+			}
+			fmt.Printf("             %s\n", line)
+		}
+	}
+	if hidden := repo.CommitsBehind - len(repo.Commits); hidden > 0 && len(repo.Commits) > 0 {
+		noun := "commits"
+		if hidden == 1 {
+			noun = "commit"
+		}
+		fmt.Printf("    … and %d older %s\n", hidden, noun)
+	}
+}
+
+// truncateSubject shortens a commit subject to at most width characters
+func truncateSubject(subject string, width int) string {
+	runes := []rune(subject)
+	if len(runes) <= width {
+		return subject
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// formatAge formats the age of a commit, e.g. "3 hours ago"
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return pluralAge(int(age.Minutes()), "minute")
+	case age < 24*time.Hour:
+		return pluralAge(int(age.Hours()), "hour")
+	case age < 30*24*time.Hour:
+		return pluralAge(int(age.Hours()/24), "day")
+	case age < 365*24*time.Hour:
+		return pluralAge(int(age.Hours()/(24*30)), "month")
+	default:
+		return pluralAge(int(age.Hours()/(24*365)), "year")
+	}
+}
+
+// pluralAge formats n units ago, e.g. "1 day ago" or "2 days ago"
+func pluralAge(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s ago", unit)
+	}
+	return fmt.Sprintf("%d %ss ago", n, unit)
+}
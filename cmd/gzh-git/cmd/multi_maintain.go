@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiMaintainFlags       BulkCommandFlags
+	multiMaintainTasks       []string
+	multiMaintainAggressive  bool
+	multiMaintainPruneExpire string
+)
+
+// multiMaintainCmd represents the multi maintain command
+var multiMaintainCmd = &cobra.Command{
+	Use:   "maintain [directory]",
+	Short: "Run gc, prune, repack and commit-graph writes across repositories",
+	Long: `Run Git maintenance in all repositories in the specified directory and
+report the size of each Git directory before and after.
+
+Tasks (--task, run in the given order):
+  gc            pack loose objects, prune expired objects, pack refs (default)
+  commit-graph  write the commit-graph file for faster log and merge-base (default)
+  prune         remove unreachable loose objects older than --prune-expire
+  repack        combine all packs into one and delete the redundant packs
+
+Maintenance is disk and CPU intensive; on a laptop, a low --parallel
+(e.g. -j 2) is usually faster overall and keeps the machine responsive.`,
+	Example: `  # Reclaim disk space in every repository
+  gz-git multi maintain ~/work
+
+  # See how much space the Git directories take, without changing anything
+  gz-git multi maintain --dry-run
+
+  # Thorough cleanup, two repositories at a time
+  gz-git multi maintain --task gc --task prune --aggressive --prune-expire now -j 2
+
+  # Sizes before and after as CSV
+  gz-git multi maintain --format csv > maintenance.csv`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMultiMaintain,
+}
+
+func init() {
+	multiCmd.AddCommand(multiMaintainCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiMaintainCmd.Flags().IntVarP(&multiMaintainFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiMaintainCmd.Flags().IntVarP(&multiMaintainFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiMaintainCmd.Flags().BoolVarP(&multiMaintainFlags.DryRun, "dry-run", "n", false, "report Git directory sizes without running any task")
+	multiMaintainCmd.Flags().BoolVarP(&multiMaintainFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiMaintainCmd.Flags().StringVar(&multiMaintainFlags.Include, "include", "", "regex pattern to include repositories")
+	multiMaintainCmd.Flags().StringVar(&multiMaintainFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiMaintainCmd.Flags().StringVar(&multiMaintainFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiMaintainCmd.Flags().BoolVar(&multiMaintainFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiMaintainCmd, &multiMaintainFlags)
+
+	// Maintain-specific flags
+	multiMaintainCmd.Flags().StringSliceVar(&multiMaintainTasks, "task", nil, "task to run: gc, commit-graph, prune, repack (repeatable; default: gc, commit-graph)")
+	multiMaintainCmd.Flags().BoolVar(&multiMaintainAggressive, "aggressive", false, "let gc optimize packs more thoroughly (much slower)")
+	multiMaintainCmd.Flags().StringVar(&multiMaintainPruneExpire, "prune-expire", repository.DefaultPruneExpire, "minimum age of unreachable objects removed by prune (e.g. now, 1.week.ago)")
+}
+
+func runMultiMaintain(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiMaintainFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiMaintainFlags.Format); err != nil {
+		return err
+	}
+
+	tasks, err := repository.ParseMaintenanceTasks(multiMaintainTasks)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiMaintainFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiMaintainFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Maintaining", multiMaintainFlags.Format)
+
+	// Build options
+	opts := repository.BulkMaintainOptions{
+		Directory:             directory,
+		Tasks:                 tasks,
+		Aggressive:            multiMaintainAggressive,
+		PruneExpire:           multiMaintainPruneExpire,
+		Parallel:              multiMaintainFlags.Parallel,
+		MaxDepth:              multiMaintainFlags.Depth,
+		DryRun:                multiMaintainFlags.DryRun,
+		Verbose:               verbose,
+		IncludeSubmodules:     multiMaintainFlags.IncludeSubmodules,
+		IncludePattern:        multiMaintainFlags.Include,
+		ExcludePattern:        multiMaintainFlags.Exclude,
+		Groups:                multiMaintainFlags.Groups,
+		ExcludeGroups:         multiMaintainFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream results as they complete
+	if multiMaintainFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryMaintainResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(multiMaintainFlags.Format) {
+		if multiMaintainFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiMaintainFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiMaintainFlags.Depth)
+		}
+	}
+
+	// Execute bulk maintain
+	result, err := client.BulkMaintain(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk maintain failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiMaintainFlags.Format) {
+		if err := writeBulkReport(multiMaintainFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayMaintainResults(result)
+	}
+
+	// Return error if there were any failures
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("maintenance failed in %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayMaintainResults displays the results of a bulk maintenance operation
+func displayMaintainResults(result *repository.BulkMaintainResult) {
+	tasks := make([]string, len(result.Tasks))
+	for i, task := range result.Tasks {
+		tasks[i] = string(task)
+	}
+
+	fmt.Println()
+	fmt.Printf("Tasks: %s\n", strings.Join(tasks, ", "))
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		displayMaintainRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	fmt.Printf("Summary: %d maintained, %d would maintain, %d failed\n",
+		result.Summary[repository.StatusMaintained],
+		result.Summary[repository.StatusWouldMaintain],
+		result.Summary[repository.StatusError])
+	switch reclaimed := result.Reclaimed(); {
+	case result.Summary[repository.StatusMaintained] == 0:
+		fmt.Printf("Size: %s\n", repository.FormatSize(result.SizeBefore))
+	case reclaimed >= 0:
+		fmt.Printf("Size: %s → %s (%s reclaimed)\n",
+			repository.FormatSize(result.SizeBefore), repository.FormatSize(result.SizeAfter), repository.FormatSize(reclaimed))
+	default:
+		fmt.Printf("Size: %s → %s (grew by %s)\n",
+			repository.FormatSize(result.SizeBefore), repository.FormatSize(result.SizeAfter), repository.FormatSize(-reclaimed))
+	}
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayMaintainRepoResult displays a single repository maintenance result
+func displayMaintainRepoResult(repo repository.RepositoryMaintainResult) {
+	var icon, details string
+	switch repo.Status {
+	case repository.StatusMaintained:
+		icon = "✓"
+		details = fmt.Sprintf("%s → %s  %d → %d loose  %d → %d packs  %s",
+			repository.FormatSize(repo.Before.Size), repository.FormatSize(repo.After.Size),
+			repo.Before.LooseObjects, repo.After.LooseObjects,
+			repo.Before.Packs, repo.After.Packs,
+			formatElapsed(repo.Duration))
+	case repository.StatusWouldMaintain:
+		icon = "~"
+		details = fmt.Sprintf("%s  %d loose  %d packs",
+			repository.FormatSize(repo.Before.Size), repo.Before.LooseObjects, repo.Before.Packs)
+	default:
+		icon = "✗"
+		details = repo.Message
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, details)
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+}
@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiRemoteFlags  BulkCommandFlags
+	multiRemoteName   string
+	multiRemoteFrom   string
+	multiRemoteTo     string
+	multiRemoteVerify bool
+	multiRemoteYes    bool
+)
+
+// multiRemoteCmd represents the multi remote command group
+var multiRemoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "Remote operations across repositories",
+	Long: `Remote operations across multiple repositories.
+
+Use "gz-git multi remote [command] --help" for more information about a command.`,
+}
+
+// multiRemoteSetURLCmd represents the multi remote set-url command
+var multiRemoteSetURLCmd = &cobra.Command{
+	Use:   "set-url [directory]",
+	Short: "Rewrite a remote URL in all repositories",
+	Long: `Rewrite the URL of a remote (default: origin) in all repositories in the
+specified directory, e.g. when moving to a new Git host.
+
+--from is a regular expression matched against the current URL; the matched
+part is replaced with --to, which may refer to submatches as $1 or ${1}.
+Repositories whose URL does not match are left unchanged. Use ${1} when the
+submatch is directly followed by letters, digits or an underscore.
+
+The rewritten URLs are listed and confirmed once before anything is changed.
+With --verify, each new URL is checked with "git ls-remote" first, and
+repositories whose new URL is unreachable keep their current URL.
+
+Push URLs configured separately (remote.<name>.pushurl) are not changed.`,
+	Example: `  # Move every clone from the old host to the new one
+  gz-git multi remote set-url --from 'git@old.example.com:(.*)' --to 'git@new.example.com:$1' ~/work
+
+  # Preview the rewritten URLs
+  gz-git multi remote set-url --from 'git@old.example.com:(.*)' --to 'git@new.example.com:$1' --dry-run
+
+  # Switch from SSH to HTTPS, only where the new URL is reachable
+  gz-git multi remote set-url --from '^git@github.com:(.*)$' --to 'https://github.com/$1' --verify --yes
+
+  # Machine-readable before/after URLs
+  gz-git multi remote set-url --from 'old-org/' --to 'new-org/' --yes --format csv > migration.csv`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMultiRemoteSetURL,
+}
+
+func init() {
+	multiCmd.AddCommand(multiRemoteCmd)
+	multiRemoteCmd.AddCommand(multiRemoteSetURLCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiRemoteSetURLCmd.Flags().IntVarP(&multiRemoteFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiRemoteSetURLCmd.Flags().IntVarP(&multiRemoteFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiRemoteSetURLCmd.Flags().BoolVarP(&multiRemoteFlags.DryRun, "dry-run", "n", false, "show the rewritten URLs without setting them")
+	multiRemoteSetURLCmd.Flags().BoolVarP(&multiRemoteFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteFlags.Include, "include", "", "regex pattern to include repositories")
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiRemoteSetURLCmd.Flags().BoolVar(&multiRemoteFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiRemoteSetURLCmd, &multiRemoteFlags)
+	addHostLimitFlag(multiRemoteSetURLCmd, &multiRemoteFlags.HostLimits)
+
+	// Remote-specific flags
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteName, "remote", "origin", "remote whose URL is rewritten")
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteFrom, "from", "", "regular expression matching the current URL (required)")
+	multiRemoteSetURLCmd.Flags().StringVar(&multiRemoteTo, "to", "", "replacement for the matched part, may use $1, ${1} (required)")
+	multiRemoteSetURLCmd.Flags().BoolVar(&multiRemoteVerify, "verify", false, "check that each new URL is reachable with ls-remote before setting it")
+	multiRemoteSetURLCmd.Flags().BoolVarP(&multiRemoteYes, "yes", "y", false, "set the URLs without asking for confirmation")
+	_ = multiRemoteSetURLCmd.MarkFlagRequired("from")
+	_ = multiRemoteSetURLCmd.MarkFlagRequired("to")
+}
+
+func runMultiRemoteSetURL(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 0 {
+		directory = args[0]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiRemoteFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiRemoteFlags.Format); err != nil {
+		return err
+	}
+
+	// The confirmation prompt would mix with machine-readable output
+	if isMachineFormat(multiRemoteFlags.Format) && !multiRemoteFlags.DryRun && !multiRemoteYes {
+		return fmt.Errorf("--format %s requires --yes or --dry-run", multiRemoteFlags.Format)
+	}
+
+	hostLimits, err := repository.ParseHostLimits(multiRemoteFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiRemoteFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiRemoteFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	// Build options
+	opts := repository.BulkRemoteSetURLOptions{
+		Directory:         directory,
+		Remote:            multiRemoteName,
+		From:              multiRemoteFrom,
+		To:                multiRemoteTo,
+		Verify:            multiRemoteVerify,
+		HostLimits:        hostLimits,
+		Parallel:          multiRemoteFlags.Parallel,
+		MaxDepth:          multiRemoteFlags.Depth,
+		DryRun:            multiRemoteFlags.DryRun,
+		Verbose:           verbose,
+		IncludeSubmodules: multiRemoteFlags.IncludeSubmodules,
+		IncludePattern:    multiRemoteFlags.Include,
+		ExcludePattern:    multiRemoteFlags.Exclude,
+		Groups:            multiRemoteFlags.Groups,
+		ExcludeGroups:     multiRemoteFlags.ExcludeGroups,
+		Workspace:         workspace,
+		Logger:            logger,
+	}
+
+	// Print header
+	if humanOutput(multiRemoteFlags.Format) {
+		if multiRemoteFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiRemoteFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiRemoteFlags.Depth)
+		}
+	}
+
+	// Preview first, so the user confirms the URLs that will actually be set.
+	// The preview skips verification; unreachable URLs are reported by the real run.
+	if !multiRemoteFlags.DryRun && !multiRemoteYes {
+		preview := opts
+		preview.DryRun = true
+		preview.Verify = false
+		result, err := client.BulkRemoteSetURL(ctx, preview)
+		if err != nil {
+			return fmt.Errorf("bulk remote set-url failed: %w", err)
+		}
+		pending := result.Summary[repository.StatusWouldUpdate]
+		if !quiet {
+			displayRemoteResults(result, multiRemoteFlags.Format)
+		}
+		if pending == 0 {
+			return nil
+		}
+		if !confirmPrompt(fmt.Sprintf("Set the %s URL of %d repositories?", result.Remote, pending)) {
+			fmt.Println("Aborted: no URLs changed")
+			return nil
+		}
+
+		// Apply only to the previewed repositories, so nothing unconfirmed changes
+		opts.OnlyRepositories = remoteRepositoriesWithStatus(result, repository.StatusWouldUpdate)
+	}
+
+	progress := newBulkProgress("Updating", multiRemoteFlags.Format)
+	opts.ProgressCallback = progress.Callback()
+	opts.ProgressEventCallback = progress.EventCallback()
+
+	// Stream results as they complete
+	if multiRemoteFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryRemoteSetURLResult) { streamRecord(r.Record()) }
+	}
+
+	// Execute bulk remote set-url
+	result, err := client.BulkRemoteSetURL(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk remote set-url failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiRemoteFlags.Format) {
+		if err := writeBulkReport(multiRemoteFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayRemoteResults(result, multiRemoteFlags.Format)
+	}
+
+	// Return error if any URL could not be set
+	failed := result.Summary[repository.StatusError] + result.Summary[repository.StatusUnreachable]
+	if failed > 0 {
+		return fmt.Errorf("remote URL not changed in %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// remoteRepositoriesWithStatus returns the paths of repositories with the given status
+func remoteRepositoriesWithStatus(result *repository.BulkRemoteSetURLResult, status string) []string {
+	var paths []string
+	for _, repo := range result.Repositories {
+		if repo.Status == status {
+			paths = append(paths, repo.Path)
+		}
+	}
+	return paths
+}
+
+// displayRemoteResults displays the results of a bulk remote URL rewrite
+func displayRemoteResults(result *repository.BulkRemoteSetURLResult, format string) {
+	fmt.Println()
+	fmt.Printf("Remote: %s\n", result.Remote)
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		// Compact format hides repositories that are not changed
+		if format == formatCompact && (repo.Status == repository.StatusNoMatch || repo.Status == repository.StatusUpToDate) {
+			continue
+		}
+		displayRemoteRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	displayRemoteSummary(result)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayRemoteRepoResult displays a single repository remote URL result
+func displayRemoteRepoResult(repo repository.RepositoryRemoteSetURLResult) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusUpdated:
+		icon = "✓"
+	case repository.StatusWouldUpdate:
+		icon = "~"
+	case repository.StatusUpToDate, repository.StatusNoMatch:
+		icon = "="
+	case repository.StatusNoRemote, repository.StatusUnreachable:
+		icon = "⚠"
+	default:
+		icon = "✗"
+	}
+
+	message := repo.Message
+	if repo.Status == repository.StatusNoMatch {
+		message = fmt.Sprintf("%s: %s", repo.Message, repo.OldURL)
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, message)
+	if repo.Error != nil && (verbose || repo.Status != repository.StatusNoRemote) {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+}
+
+// displayRemoteSummary displays the summary of bulk remote URL results
+func displayRemoteSummary(result *repository.BulkRemoteSetURLResult) {
+	labels := []struct {
+		status string
+		label  string
+	}{
+		{repository.StatusUpdated, "updated"},
+		{repository.StatusWouldUpdate, "would update"},
+		{repository.StatusUpToDate, "already set"},
+		{repository.StatusNoMatch, "no match"},
+		{repository.StatusNoRemote, "no remote"},
+		{repository.StatusUnreachable, "unreachable"},
+		{repository.StatusError, "errors"},
+	}
+
+	parts := []string{}
+	for _, l := range labels {
+		if count := result.Summary[l.status]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, l.label))
+		}
+	}
+
+	if len(parts) == 0 {
+		fmt.Println("Summary: no repositories")
+		return
+	}
+	fmt.Printf("Summary: %s\n", strings.Join(parts, ", "))
+}
@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiStashFlags     BulkCommandFlags
+	multiStashMessage   string
+	multiStashCreatedBy string
+	multiStashUntracked bool
+)
+
+// multiStashCmd represents the multi stash command
+var multiStashCmd = &cobra.Command{
+	Use:   "stash <save|list|pop|drop> [directory]",
+	Short: "Manage stash entries across repositories",
+	Long: `Save, list, pop or drop stash entries in all repositories in the specified directory.
+
+Entries created by gz-git are labelled with the operation that created them
+("gz-git:pull: ..." for 'pull --stash', "gz-git:stash: ..." for 'multi stash save',
+"gz-git:reset: ..." for 'update --strategy reset').
+Use --created-by to select only those entries, so that
+
+  gz-git multi stash pop --created-by pull
+
+restores exactly what a bulk pull put aside, without touching other stashes.
+
+Actions:
+  save   Stash local changes in every dirty repository
+  list   List stash entries
+  pop    Apply and remove the newest matching entry in each repository
+  drop   Remove the newest matching entry in each repository`,
+	Example: `  # Stash local changes in all repositories
+  gz-git multi stash save -m "before upgrade"
+
+  # Show which repositories still hold changes stashed by pull
+  gz-git multi stash list --created-by pull
+
+  # Restore them
+  gz-git multi stash pop --created-by pull
+
+  # Preview dropping the entries saved by 'multi stash save'
+  gz-git multi stash drop --created-by stash --dry-run`,
+	Args:      cobra.RangeArgs(1, 2),
+	ValidArgs: []string{"save", "list", "pop", "drop"},
+	RunE:      runMultiStash,
+}
+
+func init() {
+	multiCmd.AddCommand(multiStashCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiStashCmd.Flags().IntVarP(&multiStashFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiStashCmd.Flags().IntVarP(&multiStashFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiStashCmd.Flags().BoolVarP(&multiStashFlags.DryRun, "dry-run", "n", false, "show what would be done without doing it")
+	multiStashCmd.Flags().BoolVarP(&multiStashFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiStashCmd.Flags().StringVar(&multiStashFlags.Include, "include", "", "regex pattern to include repositories")
+	multiStashCmd.Flags().StringVar(&multiStashFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiStashCmd.Flags().StringVar(&multiStashFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiStashCmd.Flags().BoolVar(&multiStashFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiStashCmd, &multiStashFlags)
+
+	// Stash-specific flags
+	multiStashCmd.Flags().StringVarP(&multiStashMessage, "message", "m", "", "note added to the stash message (save)")
+	multiStashCmd.Flags().StringVar(&multiStashCreatedBy, "created-by", "", "select entries created by this operation, e.g. pull or stash (default for save: stash)")
+	multiStashCmd.Flags().BoolVarP(&multiStashUntracked, "include-untracked", "u", false, "also stash untracked files (save)")
+}
+
+func runMultiStash(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get action (required)
+	action := repository.StashAction(args[0])
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 1 {
+		directory = args[1]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiStashFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiStashFlags.Format); err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiStashFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiStashFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Checking stash in", multiStashFlags.Format)
+
+	// Build options
+	opts := repository.BulkStashOptions{
+		Directory:             directory,
+		Action:                action,
+		Message:               multiStashMessage,
+		CreatedBy:             multiStashCreatedBy,
+		IncludeUntracked:      multiStashUntracked,
+		Parallel:              multiStashFlags.Parallel,
+		MaxDepth:              multiStashFlags.Depth,
+		DryRun:                multiStashFlags.DryRun,
+		Verbose:               verbose,
+		IncludeSubmodules:     multiStashFlags.IncludeSubmodules,
+		IncludePattern:        multiStashFlags.Include,
+		ExcludePattern:        multiStashFlags.Exclude,
+		Groups:                multiStashFlags.Groups,
+		ExcludeGroups:         multiStashFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if multiStashFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryStashResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(multiStashFlags.Format) {
+		if multiStashFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiStashFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiStashFlags.Depth)
+		}
+	}
+
+	// Execute bulk stash
+	result, err := client.BulkStash(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk stash failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiStashFlags.Format) {
+		if err := writeBulkReport(multiStashFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayStashResults(result, multiStashFlags.Format)
+	}
+
+	// Return error if there were any failures
+	failed := result.Summary[repository.StatusError] + result.Summary[repository.StatusConflict]
+	if failed > 0 {
+		return fmt.Errorf("stash %s failed in %d %s", result.Action, failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayStashResults displays the results of a bulk stash operation
+func displayStashResults(result *repository.BulkStashResult, format string) {
+	fmt.Println()
+	fmt.Printf("Action: stash %s\n", result.Action)
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		// Compact format hides repositories with nothing to report
+		if format == formatCompact && (repo.Status == repository.StatusNoStash || repo.Status == repository.StatusClean) {
+			continue
+		}
+		displayStashRepoResult(repo, result.Action)
+	}
+
+	// Display summary
+	fmt.Println()
+	displayStashSummary(result)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayStashRepoResult displays a single repository stash result
+func displayStashRepoResult(repo repository.RepositoryStashResult, action repository.StashAction) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusStashed, repository.StatusPopped, repository.StatusDropped:
+		icon = "✓"
+	case repository.StatusHasStash:
+		icon = "●"
+	case repository.StatusNoStash, repository.StatusClean:
+		icon = "="
+	case repository.StatusWouldStash, repository.StatusWouldPop, repository.StatusWouldDrop:
+		icon = "~"
+	case repository.StatusConflict, repository.StatusSkipped:
+		icon = "⚠"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+	if repo.Error != nil && verbose {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+
+	// List entries for the list action
+	if action == repository.StashList {
+		for _, entry := range repo.Stashes {
+			fmt.Printf("    %-12s %-20s %s\n", entry.Ref, entry.Branch, entry.Message)
+		}
+	}
+}
+
+// displayStashSummary displays the summary of bulk stash results
+func displayStashSummary(result *repository.BulkStashResult) {
+	labels := []struct {
+		status string
+		label  string
+	}{
+		{repository.StatusStashed, "stashed"},
+		{repository.StatusPopped, "popped"},
+		{repository.StatusDropped, "dropped"},
+		{repository.StatusHasStash, "with stashes"},
+		{repository.StatusWouldStash, "would-stash"},
+		{repository.StatusWouldPop, "would-pop"},
+		{repository.StatusWouldDrop, "would-drop"},
+		{repository.StatusNoStash, "no stashes"},
+		{repository.StatusClean, "clean"},
+		{repository.StatusSkipped, "skipped"},
+		{repository.StatusConflict, "conflicts"},
+		{repository.StatusError, "errors"},
+	}
+
+	parts := []string{}
+	for _, l := range labels {
+		if count := result.Summary[l.status]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, l.label))
+		}
+	}
+
+	if len(parts) == 0 {
+		fmt.Println("Summary: no repositories")
+		return
+	}
+	fmt.Printf("Summary: %s\n", strings.Join(parts, ", "))
+}
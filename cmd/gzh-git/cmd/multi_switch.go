@@ -74,7 +74,10 @@ func init() {
 	multiSwitchCmd.Flags().BoolVarP(&multiSwitchFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
 	multiSwitchCmd.Flags().StringVar(&multiSwitchFlags.Include, "include", "", "regex pattern to include repositories")
 	multiSwitchCmd.Flags().StringVar(&multiSwitchFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
-	multiSwitchCmd.Flags().StringVar(&multiSwitchFlags.Format, "format", "default", "output format: default, compact")
+	multiSwitchCmd.Flags().StringVar(&multiSwitchFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiSwitchCmd.Flags().BoolVar(&multiSwitchFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiSwitchCmd, &multiSwitchFlags)
+	addRunJournalFlags(multiSwitchCmd, &multiSwitchFlags)
 
 	// Switch-specific flags
 	multiSwitchCmd.Flags().BoolVarP(&multiSwitchCreate, "create", "c", false, "create branch if it doesn't exist")
@@ -103,31 +106,65 @@ func runMultiSwitch(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Validate format
+	if err := validateBulkFormat(multiSwitchFlags.Format); err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiSwitchFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create client
-	client := repository.NewClient()
+	client := newBulkClient(multiSwitchFlags.Rescan)
 
 	// Create logger for verbose mode
 	logger := createBulkLogger(verbose)
 
+	progress := newBulkProgress("Switching", multiSwitchFlags.Format)
+
 	// Build options
 	opts := repository.BulkSwitchOptions{
-		Directory:         directory,
-		Branch:            branch,
-		Parallel:          multiSwitchFlags.Parallel,
-		MaxDepth:          multiSwitchFlags.Depth,
-		DryRun:            multiSwitchFlags.DryRun,
-		Verbose:           verbose,
-		Create:            multiSwitchCreate,
-		Force:             multiSwitchForce,
-		IncludeSubmodules: multiSwitchFlags.IncludeSubmodules,
-		IncludePattern:    multiSwitchFlags.Include,
-		ExcludePattern:    multiSwitchFlags.Exclude,
-		Logger:            logger,
-		ProgressCallback:  createProgressCallback("Switching", multiSwitchFlags.Format, quiet),
+		Directory:             directory,
+		Branch:                branch,
+		Parallel:              multiSwitchFlags.Parallel,
+		MaxDepth:              multiSwitchFlags.Depth,
+		DryRun:                multiSwitchFlags.DryRun,
+		Verbose:               verbose,
+		Create:                multiSwitchCreate,
+		Force:                 multiSwitchForce,
+		IncludeSubmodules:     multiSwitchFlags.IncludeSubmodules,
+		IncludePattern:        multiSwitchFlags.Include,
+		ExcludePattern:        multiSwitchFlags.Exclude,
+		Groups:                multiSwitchFlags.Groups,
+		ExcludeGroups:         multiSwitchFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if multiSwitchFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositorySwitchResult) { streamRecord(r.Record()) }
+	}
+
+	// Select repositories from the last recorded run
+	selection, err := selectFromLastRun("switch", directory, &multiSwitchFlags)
+	if err != nil {
+		return err
+	}
+	if selection != nil {
+		if !applyRunSelection("switch", selection, multiSwitchFlags.Format) {
+			return nil
+		}
+		opts.OnlyRepositories = selection.Paths
 	}
 
 	// Print header
-	if !quiet {
+	if humanOutput(multiSwitchFlags.Format) {
 		if multiSwitchFlags.DryRun {
 			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiSwitchFlags.Depth)
 		} else {
@@ -137,13 +174,23 @@ func runMultiSwitch(cmd *cobra.Command, args []string) error {
 
 	// Execute bulk switch
 	result, err := client.BulkSwitch(ctx, opts)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("bulk switch failed: %w", err)
 	}
 
+	// Record the run so failed repositories can be retried
+	report := result.Report()
+	run := recordRun(directory, &multiSwitchFlags, report)
+
 	// Display results
-	if !quiet {
+	if isMachineFormat(multiSwitchFlags.Format) {
+		if err := writeBulkReport(multiSwitchFlags.Format, report); err != nil {
+			return err
+		}
+	} else if !quiet {
 		displaySwitchResults(result)
+		displayRetryHint(run)
 	}
 
 	// Return error if there were any failures
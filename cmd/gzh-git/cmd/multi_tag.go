@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	multiTagFlags        BulkCommandFlags
+	multiTagMessage      string
+	multiTagBranch       string
+	multiTagPush         bool
+	multiTagRemote       string
+	multiTagAllOrNothing bool
+)
+
+// multiTagCmd represents the multi tag command group
+var multiTagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Tag operations across repositories",
+	Long: `Tag operations across multiple repositories.
+
+Use "gz-git multi tag [command] --help" for more information about a command.`,
+}
+
+// multiTagCreateCmd represents the multi tag create command
+var multiTagCreateCmd = &cobra.Command{
+	Use:   "create <tag> [directory]",
+	Short: "Create the same annotated tag in all repositories",
+	Long: `Create the same annotated tag in all repositories in the specified directory,
+optionally pushing it.
+
+Every repository is verified before anything is tagged:
+  - the working tree is clean
+  - the current branch matches --branch (if given)
+  - the tag does not exist yet
+  - the remote exists (with --push)
+
+With --all-or-nothing, no tag is created unless every repository passes
+verification, and if creating or pushing fails anywhere, the tags already
+created (and pushed) are deleted again.`,
+	Example: `  # Tag all service repositories on main and push
+  gz-git multi tag create v1.4.0 -m "Release 1.4.0" --branch main --push ~/services
+
+  # Release atomically: either every repository is tagged or none is
+  gz-git multi tag create v1.4.0 -m "Release 1.4.0" --push --all-or-nothing
+
+  # Check that every repository is ready to be tagged
+  gz-git multi tag create v1.4.0 --branch main --dry-run`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runMultiTagCreate,
+}
+
+func init() {
+	multiCmd.AddCommand(multiTagCmd)
+	multiTagCmd.AddCommand(multiTagCreateCmd)
+
+	// Common bulk operation flags (except watch/interval which don't apply)
+	multiTagCreateCmd.Flags().IntVarP(&multiTagFlags.Depth, "depth", "d", repository.DefaultBulkMaxDepth, "directory depth to scan")
+	multiTagCreateCmd.Flags().IntVarP(&multiTagFlags.Parallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+	multiTagCreateCmd.Flags().BoolVarP(&multiTagFlags.DryRun, "dry-run", "n", false, "verify repositories without creating tags")
+	multiTagCreateCmd.Flags().BoolVarP(&multiTagFlags.IncludeSubmodules, "recursive", "r", false, "recursively include nested repositories and submodules")
+	multiTagCreateCmd.Flags().StringVar(&multiTagFlags.Include, "include", "", "regex pattern to include repositories")
+	multiTagCreateCmd.Flags().StringVar(&multiTagFlags.Exclude, "exclude", "", "regex pattern to exclude repositories")
+	multiTagCreateCmd.Flags().StringVar(&multiTagFlags.Format, "format", formatDefault, bulkFormatHelp)
+	multiTagCreateCmd.Flags().BoolVar(&multiTagFlags.Rescan, "rescan", false, "ignore the repository discovery index and rescan the directory tree")
+	addGroupFlags(multiTagCreateCmd, &multiTagFlags)
+
+	// Tag-specific flags
+	multiTagCreateCmd.Flags().StringVarP(&multiTagMessage, "message", "m", "", "tag message (default: the tag name)")
+	multiTagCreateCmd.Flags().StringVarP(&multiTagBranch, "branch", "b", "", "require every repository to be on this branch")
+	multiTagCreateCmd.Flags().BoolVar(&multiTagPush, "push", false, "push the tag after creating it")
+	multiTagCreateCmd.Flags().StringVar(&multiTagRemote, "remote", "origin", "remote to push the tag to")
+	multiTagCreateCmd.Flags().BoolVar(&multiTagAllOrNothing, "all-or-nothing", false, "tag every repository or none, deleting created tags on failure")
+}
+
+func runMultiTagCreate(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get tag name (required)
+	tag := args[0]
+
+	// Get directory (optional, defaults to current)
+	directory := "."
+	if len(args) > 1 {
+		directory = args[1]
+	}
+
+	// Validate directory exists
+	if _, err := os.Stat(directory); err != nil {
+		return fmt.Errorf("directory does not exist: %s", directory)
+	}
+
+	// Validate depth
+	if err := validateBulkDepth(cmd, multiTagFlags.Depth); err != nil {
+		return err
+	}
+
+	// Validate format
+	if err := validateBulkFormat(multiTagFlags.Format); err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&multiTagFlags)
+	if err != nil {
+		return err
+	}
+
+	// Create client
+	client := newBulkClient(multiTagFlags.Rescan)
+
+	// Create logger for verbose mode
+	logger := createBulkLogger(verbose)
+
+	progress := newBulkProgress("Verifying", multiTagFlags.Format)
+
+	// Build options
+	opts := repository.BulkTagOptions{
+		Directory:             directory,
+		Tag:                   tag,
+		Message:               multiTagMessage,
+		Branch:                multiTagBranch,
+		Push:                  multiTagPush,
+		Remote:                multiTagRemote,
+		AllOrNothing:          multiTagAllOrNothing,
+		Parallel:              multiTagFlags.Parallel,
+		MaxDepth:              multiTagFlags.Depth,
+		DryRun:                multiTagFlags.DryRun,
+		Verbose:               verbose,
+		IncludeSubmodules:     multiTagFlags.IncludeSubmodules,
+		IncludePattern:        multiTagFlags.Include,
+		ExcludePattern:        multiTagFlags.Exclude,
+		Groups:                multiTagFlags.Groups,
+		ExcludeGroups:         multiTagFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository once all phases are done
+	if multiTagFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryTagResult) { streamRecord(r.Record()) }
+	}
+
+	// Print header
+	if humanOutput(multiTagFlags.Format) {
+		if multiTagFlags.DryRun {
+			fmt.Printf("Scanning for repositories in %s (depth: %d) [DRY-RUN]...\n", directory, multiTagFlags.Depth)
+		} else {
+			fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, multiTagFlags.Depth)
+		}
+	}
+
+	// Execute bulk tag
+	result, err := client.BulkTag(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("bulk tag failed: %w", err)
+	}
+
+	// Display results
+	if isMachineFormat(multiTagFlags.Format) {
+		if err := writeBulkReport(multiTagFlags.Format, result.Report()); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displayTagResults(result)
+	}
+
+	// Return error if any repository was not tagged as requested
+	failed := 0
+	for _, repo := range result.Repositories {
+		if !repository.IsSuccessStatus(repo.Status) && !repository.IsDryRunStatus(repo.Status) {
+			failed++
+		}
+	}
+	if result.RolledBack {
+		errored := result.Summary[repository.StatusError]
+		return fmt.Errorf("tag %s failed in %d %s; created tags were rolled back",
+			result.Tag, errored, repository.PluralSuffix(errored, "repository", "repositories"))
+	}
+	if failed > 0 {
+		return fmt.Errorf("tag %s not created in %d %s", result.Tag, failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayTagResults displays the results of a bulk tag operation
+func displayTagResults(result *repository.BulkTagResult) {
+	fmt.Println()
+	fmt.Printf("Tag: %s\n", result.Tag)
+	fmt.Printf("Scanned: %d repositories\n", result.TotalScanned)
+	fmt.Printf("Processed: %d repositories\n", result.TotalProcessed)
+	fmt.Println()
+
+	// Display each repository result
+	for _, repo := range result.Repositories {
+		displayTagRepoResult(repo)
+	}
+
+	// Display summary
+	fmt.Println()
+	if result.RolledBack {
+		fmt.Println("Rolled back: tags created by this run were deleted")
+	}
+	displayTagSummary(result)
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
+
+// displayTagRepoResult displays a single repository tag result
+func displayTagRepoResult(repo repository.RepositoryTagResult) {
+	var icon string
+	switch repo.Status {
+	case repository.StatusTagged, repository.StatusPushed:
+		icon = "✓"
+	case repository.StatusWouldTag:
+		icon = "~"
+	case repository.StatusRolledBack, repository.StatusSkipped:
+		icon = "⊘"
+	case repository.StatusDirty, repository.StatusWrongBranch, repository.StatusTagExists, repository.StatusNoRemote:
+		icon = "⚠"
+	default:
+		icon = "✗"
+	}
+
+	fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+	if repo.Error != nil && (verbose || repo.Status == repository.StatusError) {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	}
+}
+
+// displayTagSummary displays the summary of bulk tag results
+func displayTagSummary(result *repository.BulkTagResult) {
+	labels := []struct {
+		status string
+		label  string
+	}{
+		{repository.StatusPushed, "pushed"},
+		{repository.StatusTagged, "tagged"},
+		{repository.StatusWouldTag, "would-tag"},
+		{repository.StatusRolledBack, "rolled back"},
+		{repository.StatusSkipped, "skipped"},
+		{repository.StatusDirty, "dirty"},
+		{repository.StatusWrongBranch, "wrong branch"},
+		{repository.StatusTagExists, "tag exists"},
+		{repository.StatusNoRemote, "no remote"},
+		{repository.StatusError, "errors"},
+	}
+
+	parts := []string{}
+	for _, l := range labels {
+		if count := result.Summary[l.status]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, l.label))
+		}
+	}
+
+	if len(parts) == 0 {
+		fmt.Println("Summary: no repositories")
+		return
+	}
+	fmt.Printf("Summary: %s\n", strings.Join(parts, ", "))
+}
@@ -85,12 +85,15 @@ func init() {
 
 	// Common bulk operation flags
 	addBulkFlags(pullCmd, &pullFlags)
+	addRunJournalFlags(pullCmd, &pullFlags)
+	addRetryFlags(pullCmd, &pullFlags)
+	addHostLimitFlag(pullCmd, &pullFlags.HostLimits)
 
 	// Pull-specific flags
 	pullCmd.Flags().StringVarP(&pullStrategy, "strategy", "s", "merge", "pull strategy: merge, rebase, ff-only")
 	pullCmd.Flags().BoolVarP(&pullPrune, "prune", "p", false, "prune remote-tracking branches that no longer exist")
 	pullCmd.Flags().BoolVarP(&pullTags, "tags", "t", false, "fetch all tags from remote")
-	pullCmd.Flags().BoolVar(&pullStash, "stash", false, "automatically stash local changes before pull")
+	pullCmd.Flags().BoolVar(&pullStash, "stash", false, "automatically stash local changes before pull (leftovers: 'multi stash pop --created-by pull')")
 }
 
 func runPull(cmd *cobra.Command, args []string) error {
@@ -107,28 +110,70 @@ func runPull(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Validate format
+	if err := validateBulkFormat(pullFlags.Format); err != nil {
+		return err
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(pullFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&pullFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create client
-	client := repository.NewClient()
+	client := newBulkClient(pullFlags.Rescan)
 
 	// Create logger for verbose mode
 	logger := createBulkLogger(verbose)
 
+	progress := newBulkProgress("Pulling", pullFlags.Format)
+
 	// Build options
 	opts := repository.BulkPullOptions{
-		Directory:         directory,
-		Parallel:          pullFlags.Parallel,
-		MaxDepth:          pullFlags.Depth,
-		DryRun:            pullFlags.DryRun,
-		Verbose:           verbose,
-		Strategy:          pullStrategy,
-		Prune:             pullPrune,
-		Tags:              pullTags,
-		Stash:             pullStash,
-		IncludeSubmodules: pullFlags.IncludeSubmodules,
-		IncludePattern:    pullFlags.Include,
-		ExcludePattern:    pullFlags.Exclude,
-		Logger:            logger,
-		ProgressCallback:  createProgressCallback("Pulling", pullFlags.Format, quiet),
+		Directory:             directory,
+		Parallel:              pullFlags.Parallel,
+		HostLimits:            hostLimits,
+		MaxDepth:              pullFlags.Depth,
+		DryRun:                pullFlags.DryRun,
+		Verbose:               verbose,
+		Strategy:              pullStrategy,
+		Prune:                 pullPrune,
+		Tags:                  pullTags,
+		Stash:                 pullStash,
+		Retry:                 retryPolicy(&pullFlags),
+		IncludeSubmodules:     pullFlags.IncludeSubmodules,
+		IncludePattern:        pullFlags.Include,
+		ExcludePattern:        pullFlags.Exclude,
+		Groups:                pullFlags.Groups,
+		ExcludeGroups:         pullFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if pullFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryPullResult) { streamRecord(r.Record()) }
+	}
+
+	// Select repositories from the last recorded run
+	selection, err := selectFromLastRun("pull", directory, &pullFlags)
+	if err != nil {
+		return err
+	}
+	if selection != nil {
+		if !applyRunSelection("pull", selection, pullFlags.Format) {
+			return nil
+		}
+		opts.OnlyRepositories = selection.Paths
 	}
 
 	// Watch mode: continuously pull at intervals
@@ -137,30 +182,41 @@ func runPull(cmd *cobra.Command, args []string) error {
 	}
 
 	// One-time pull
-	if !quiet {
+	if humanOutput(pullFlags.Format) {
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, pullFlags.Depth)
 	}
 
 	result, err := client.BulkPull(ctx, opts)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("bulk pull failed: %w", err)
 	}
 
+	// Record the run so failed repositories can be retried
+	report := result.Report()
+	run := recordRun(directory, &pullFlags, report)
+
+	// Machine-readable output
+	if isMachineFormat(pullFlags.Format) {
+		return writeBulkReport(pullFlags.Format, report)
+	}
+
 	// Display scan completion message
-	if !quiet && result.TotalScanned == 0 {
+	if humanOutput(pullFlags.Format) && result.TotalScanned == 0 {
 		fmt.Printf("Scan complete: no repositories found\n")
 	}
 
 	// Display results
-	if !quiet {
+	if humanOutput(pullFlags.Format) {
 		displayPullResults(result)
+		displayRetryHint(run)
 	}
 
 	return nil
 }
 
 func runPullWatch(ctx context.Context, client repository.Client, opts repository.BulkPullOptions) error {
-	if !quiet {
+	if humanOutput(pullFlags.Format) {
 		fmt.Printf("Starting watch mode: pulling every %s\n", pullFlags.Interval)
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", opts.Directory, opts.MaxDepth)
 		fmt.Println("Press Ctrl+C to stop...")
@@ -184,13 +240,13 @@ func runPullWatch(ctx context.Context, client repository.Client, opts repository
 	for {
 		select {
 		case <-sigChan:
-			if !quiet {
+			if humanOutput(pullFlags.Format) {
 				fmt.Println("\nStopping watch...")
 			}
 			return nil
 
 		case <-ticker.C:
-			if !quiet && pullFlags.Format != "compact" {
+			if humanOutput(pullFlags.Format) && pullFlags.Format != "compact" {
 				fmt.Printf("\n[%s] Running scheduled pull...\n", time.Now().Format("15:04:05"))
 			}
 			if err := executePull(ctx, client, opts); err != nil {
@@ -212,8 +268,13 @@ func executePull(ctx context.Context, client repository.Client, opts repository.
 		return fmt.Errorf("bulk pull failed: %w", err)
 	}
 
+	// Machine-readable output
+	if isMachineFormat(pullFlags.Format) {
+		return writeBulkReport(pullFlags.Format, result.Report())
+	}
+
 	// Display results
-	if !quiet {
+	if humanOutput(pullFlags.Format) {
 		displayPullResults(result)
 	}
 
@@ -349,6 +410,10 @@ func displayPullRepositoryResult(repo repository.RepositoryPullResult) {
 	if repo.Error != nil && verbose {
 		fmt.Printf("    Error: %v\n", repo.Error)
 	}
+	// Show retries of transient network failures
+	if repo.Attempts > 1 {
+		fmt.Printf("    Attempts: %d (transient failures retried)\n", repo.Attempts)
+	}
 }
 
 // getPullStatusIconWithContext returns the appropriate icon based on status and actual changes.
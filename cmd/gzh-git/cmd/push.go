@@ -95,6 +95,9 @@ func init() {
 
 	// Common bulk operation flags
 	addBulkFlags(pushCmd, &pushFlags)
+	addRunJournalFlags(pushCmd, &pushFlags)
+	addRetryFlags(pushCmd, &pushFlags)
+	addHostLimitFlag(pushCmd, &pushFlags.HostLimits)
 
 	// Push-specific flags
 	pushCmd.Flags().BoolVarP(&pushForce, "force", "f", false, "force push (use with caution!)")
@@ -119,30 +122,72 @@ func runPush(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Validate format
+	if err := validateBulkFormat(pushFlags.Format); err != nil {
+		return err
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(pushFlags.HostLimits)
+	if err != nil {
+		return err
+	}
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&pushFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create client
-	client := repository.NewClient()
+	client := newBulkClient(pushFlags.Rescan)
 
 	// Create logger for verbose mode
 	logger := createBulkLogger(verbose)
 
+	progress := newBulkProgress("Pushing", pushFlags.Format)
+
 	// Build options
 	opts := repository.BulkPushOptions{
-		Directory:         directory,
-		Parallel:          pushFlags.Parallel,
-		MaxDepth:          pushFlags.Depth,
-		DryRun:            pushFlags.DryRun,
-		Verbose:           verbose,
-		Force:             pushForce,
-		SetUpstream:       pushSetUpstream,
-		Tags:              pushTags,
-		Refspec:           pushRefspec,
-		Remotes:           pushRemotes,
-		AllRemotes:        pushAllRemotes,
-		IncludeSubmodules: pushFlags.IncludeSubmodules,
-		IncludePattern:    pushFlags.Include,
-		ExcludePattern:    pushFlags.Exclude,
-		Logger:            logger,
-		ProgressCallback:  createProgressCallback("Pushing", pushFlags.Format, quiet),
+		Directory:             directory,
+		Parallel:              pushFlags.Parallel,
+		HostLimits:            hostLimits,
+		MaxDepth:              pushFlags.Depth,
+		DryRun:                pushFlags.DryRun,
+		Verbose:               verbose,
+		Force:                 pushForce,
+		SetUpstream:           pushSetUpstream,
+		Tags:                  pushTags,
+		Refspec:               pushRefspec,
+		Remotes:               pushRemotes,
+		AllRemotes:            pushAllRemotes,
+		Retry:                 retryPolicy(&pushFlags),
+		IncludeSubmodules:     pushFlags.IncludeSubmodules,
+		IncludePattern:        pushFlags.Include,
+		ExcludePattern:        pushFlags.Exclude,
+		Groups:                pushFlags.Groups,
+		ExcludeGroups:         pushFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if pushFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryPushResult) { streamRecord(r.Record()) }
+	}
+
+	// Select repositories from the last recorded run
+	selection, err := selectFromLastRun("push", directory, &pushFlags)
+	if err != nil {
+		return err
+	}
+	if selection != nil {
+		if !applyRunSelection("push", selection, pushFlags.Format) {
+			return nil
+		}
+		opts.OnlyRepositories = selection.Paths
 	}
 
 	// Watch mode: continuously push at intervals
@@ -151,30 +196,41 @@ func runPush(cmd *cobra.Command, args []string) error {
 	}
 
 	// One-time push
-	if !quiet {
+	if humanOutput(pushFlags.Format) {
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, pushFlags.Depth)
 	}
 
 	result, err := client.BulkPush(ctx, opts)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("bulk push failed: %w", err)
 	}
 
+	// Record the run so failed repositories can be retried
+	report := result.Report()
+	run := recordRun(directory, &pushFlags, report)
+
+	// Machine-readable output
+	if isMachineFormat(pushFlags.Format) {
+		return writeBulkReport(pushFlags.Format, report)
+	}
+
 	// Display scan completion message
-	if !quiet && result.TotalScanned == 0 {
+	if humanOutput(pushFlags.Format) && result.TotalScanned == 0 {
 		fmt.Printf("Scan complete: no repositories found\n")
 	}
 
 	// Display results
-	if !quiet {
+	if humanOutput(pushFlags.Format) {
 		displayPushResults(result)
+		displayRetryHint(run)
 	}
 
 	return nil
 }
 
 func runPushWatch(ctx context.Context, client repository.Client, opts repository.BulkPushOptions) error {
-	if !quiet {
+	if humanOutput(pushFlags.Format) {
 		fmt.Printf("Starting watch mode: pushing every %s\n", pushFlags.Interval)
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", opts.Directory, opts.MaxDepth)
 		fmt.Println("Press Ctrl+C to stop...")
@@ -198,13 +254,13 @@ func runPushWatch(ctx context.Context, client repository.Client, opts repository
 	for {
 		select {
 		case <-sigChan:
-			if !quiet {
+			if humanOutput(pushFlags.Format) {
 				fmt.Println("\nStopping watch...")
 			}
 			return nil
 
 		case <-ticker.C:
-			if !quiet && pushFlags.Format != "compact" {
+			if humanOutput(pushFlags.Format) && pushFlags.Format != "compact" {
 				fmt.Printf("\n[%s] Running scheduled push...\n", time.Now().Format("15:04:05"))
 			}
 			if err := executePush(ctx, client, opts); err != nil {
@@ -226,8 +282,13 @@ func executePush(ctx context.Context, client repository.Client, opts repository.
 		return fmt.Errorf("bulk push failed: %w", err)
 	}
 
+	// Machine-readable output
+	if isMachineFormat(pushFlags.Format) {
+		return writeBulkReport(pushFlags.Format, result.Report())
+	}
+
 	// Display results
-	if !quiet {
+	if humanOutput(pushFlags.Format) {
 		displayPushResults(result)
 	}
 
@@ -351,6 +412,10 @@ func displayPushRepositoryResult(repo repository.RepositoryPushResult) {
 	if repo.Error != nil && verbose {
 		fmt.Printf("    Error: %v\n", repo.Error)
 	}
+	// Show retries of transient network failures
+	if repo.Attempts > 1 {
+		fmt.Printf("    Attempts: %d (transient failures retried)\n", repo.Attempts)
+	}
 }
 
 // getPushStatusIconWithContext returns the appropriate icon based on status and actual changes.
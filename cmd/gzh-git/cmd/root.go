@@ -13,8 +13,9 @@ var (
 	appVersion string
 
 	// Global flags
-	verbose bool
-	quiet   bool
+	verbose      bool
+	quiet        bool
+	progressMode = progressModeFlag(progressModeAuto)
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -51,6 +52,7 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "quiet output (errors only)")
+	rootCmd.PersistentFlags().Var(&progressMode, "progress", "bulk progress display: auto (table on a terminal), table or lines")
 
 	// Version template
 	rootCmd.SetVersionTemplate(`{{with .Name}}{{printf "%s " .}}{{end}}{{printf "version %s" .Version}}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -13,7 +14,11 @@ import (
 	"github.com/gizzahub/gzh-cli-git/pkg/repository"
 )
 
-var statusFlags BulkCommandFlags
+var (
+	statusFlags  BulkCommandFlags
+	statusReport string
+	statusOutput string
+)
 
 // statusCmd represents the status command
 var statusCmd = &cobra.Command{
@@ -52,7 +57,13 @@ The command is read-only and will not modify your repositories.`,
   gz-git status --format compact ~/projects
 
   # Continuously check at intervals (watch mode)
-  gz-git status -d 2 --watch --interval 30s ~/projects`,
+  gz-git status -d 2 --watch --interval 30s ~/projects
+
+  # Write a shareable HTML report grouped by state
+  gz-git status --report html -o report.html ~/projects
+
+  # Markdown report to stdout, e.g. for a wiki page or issue
+  gz-git status --report markdown ~/projects`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runStatus,
 }
@@ -62,6 +73,10 @@ func init() {
 
 	// Common bulk operation flags
 	addBulkFlags(statusCmd, &statusFlags)
+
+	// Report flags
+	statusCmd.Flags().StringVar(&statusReport, "report", "", "write a report grouped by state: html or markdown")
+	statusCmd.Flags().StringVarP(&statusOutput, "output", "o", "", "file to write the report to (default: stdout)")
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
@@ -78,23 +93,56 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Validate format
+	if err := validateBulkFormat(statusFlags.Format); err != nil {
+		return err
+	}
+
+	// Validate report flags
+	if err := validateStatusReport(); err != nil {
+		return err
+	}
+	reportToStdout := statusReport != "" && statusOutput == ""
+
+	// Load workspace manifest for group selection
+	workspace, err := loadBulkWorkspace(&statusFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create client
-	client := repository.NewClient()
+	client := newBulkClient(statusFlags.Rescan)
 
 	// Create logger for verbose mode
 	logger := createBulkLogger(verbose)
 
+	// A report written to stdout must not be mixed with progress output
+	progressFormat := statusFlags.Format
+	if reportToStdout {
+		progressFormat = statusReport
+	}
+	progress := newBulkProgress("Checking status", progressFormat)
+
 	// Build options
 	opts := repository.BulkStatusOptions{
-		Directory:         directory,
-		Parallel:          statusFlags.Parallel,
-		MaxDepth:          statusFlags.Depth,
-		Verbose:           verbose,
-		IncludeSubmodules: statusFlags.IncludeSubmodules,
-		IncludePattern:    statusFlags.Include,
-		ExcludePattern:    statusFlags.Exclude,
-		Logger:            logger,
-		ProgressCallback:  createProgressCallback("Checking status", statusFlags.Format, quiet),
+		Directory:             directory,
+		Parallel:              statusFlags.Parallel,
+		MaxDepth:              statusFlags.Depth,
+		Verbose:               verbose,
+		IncludeSubmodules:     statusFlags.IncludeSubmodules,
+		IncludePattern:        statusFlags.Include,
+		ExcludePattern:        statusFlags.Exclude,
+		Groups:                statusFlags.Groups,
+		ExcludeGroups:         statusFlags.ExcludeGroups,
+		Workspace:             workspace,
+		Logger:                logger,
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if statusFlags.Format == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositoryStatusResult) { streamRecord(r.Record()) }
 	}
 
 	// Watch mode: continuously check at intervals
@@ -103,22 +151,33 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// One-time status check
-	if !quiet {
+	if humanOutput(statusFlags.Format) && !reportToStdout {
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", directory, statusFlags.Depth)
 	}
 
 	result, err := client.BulkStatus(ctx, opts)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("bulk status failed: %w", err)
 	}
 
+	// Report grouped by state
+	if statusReport != "" {
+		return writeStatusReport(directory, result)
+	}
+
+	// Machine-readable output
+	if isMachineFormat(statusFlags.Format) {
+		return writeBulkReport(statusFlags.Format, result.Report())
+	}
+
 	// Display scan completion message
-	if !quiet && result.TotalScanned == 0 {
+	if humanOutput(statusFlags.Format) && result.TotalScanned == 0 {
 		fmt.Printf("Scan complete: no repositories found\n")
 	}
 
 	// Display results
-	if !quiet {
+	if humanOutput(statusFlags.Format) {
 		displayStatusResults(result)
 	}
 
@@ -126,7 +185,7 @@ func runStatus(cmd *cobra.Command, args []string) error {
 }
 
 func runStatusWatch(ctx context.Context, client repository.Client, opts repository.BulkStatusOptions) error {
-	if !quiet {
+	if humanOutput(statusFlags.Format) {
 		fmt.Printf("Starting watch mode: checking every %s\n", statusFlags.Interval)
 		fmt.Printf("Scanning for repositories in %s (depth: %d)...\n", opts.Directory, opts.MaxDepth)
 		fmt.Println("Press Ctrl+C to stop...")
@@ -150,13 +209,13 @@ func runStatusWatch(ctx context.Context, client repository.Client, opts reposito
 	for {
 		select {
 		case <-sigChan:
-			if !quiet {
+			if humanOutput(statusFlags.Format) {
 				fmt.Println("\nStopping watch...")
 			}
 			return nil
 
 		case <-ticker.C:
-			if !quiet && statusFlags.Format != "compact" {
+			if humanOutput(statusFlags.Format) && statusFlags.Format != "compact" {
 				fmt.Printf("\n[%s] Running scheduled status check...\n", time.Now().Format("15:04:05"))
 			}
 			if err := executeStatus(ctx, client, opts); err != nil {
@@ -178,8 +237,13 @@ func executeStatus(ctx context.Context, client repository.Client, opts repositor
 		return fmt.Errorf("bulk status failed: %w", err)
 	}
 
+	// Machine-readable output
+	if isMachineFormat(statusFlags.Format) {
+		return writeBulkReport(statusFlags.Format, result.Report())
+	}
+
 	// Display results
-	if !quiet {
+	if humanOutput(statusFlags.Format) {
 		displayStatusResults(result)
 	}
 
@@ -330,3 +394,55 @@ func getStatusIconForStatus(status string) string {
 		return "•"
 	}
 }
+
+// validateStatusReport validates the report flags
+func validateStatusReport() error {
+	if statusReport == "" {
+		if statusOutput != "" {
+			return fmt.Errorf("--output requires --report")
+		}
+		return nil
+	}
+
+	switch statusReport {
+	case repository.StatusReportHTML, repository.StatusReportMarkdown:
+	default:
+		return fmt.Errorf("invalid report format %q: must be %s or %s", statusReport, repository.StatusReportHTML, repository.StatusReportMarkdown)
+	}
+	if statusFlags.Watch {
+		return fmt.Errorf("--report cannot be used with --watch")
+	}
+	if statusFlags.Format != formatDefault {
+		return fmt.Errorf("--report cannot be used with --format")
+	}
+	return nil
+}
+
+// writeStatusReport writes the status report to --output, or stdout
+func writeStatusReport(directory string, result *repository.BulkStatusResult) error {
+	opts := repository.StatusReportOptions{Directory: directory}
+	if abs, err := filepath.Abs(directory); err == nil {
+		opts.Directory = abs
+	}
+
+	if statusOutput == "" {
+		return repository.WriteStatusReport(os.Stdout, statusReport, result, opts)
+	}
+
+	file, err := os.Create(statusOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	if err := repository.WriteStatusReport(file, statusReport, result, opts); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+
+	if !quiet {
+		fmt.Printf("Wrote %s report for %d repositories to %s\n", statusReport, len(result.Repositories), statusOutput)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	syncParallel   int
+	syncDryRun     bool
+	syncForce      bool
+	syncFormat     string
+	syncHostLimits []string
+
+	syncGroups        []string
+	syncExcludeGroups []string
+)
+
+// syncCmd represents the sync command
+var syncCmd = &cobra.Command{
+	Use:   "sync [manifest]",
+	Short: "Clone or update all repositories declared in a workspace manifest",
+	Long: `Clone missing repositories and update existing ones as declared by a
+workspace manifest (default: .gz-git.yaml in the current directory).
+
+Each manifest entry declares a repository URL and optionally a target path,
+branch, clone depth and update strategy. Repositories that do not exist yet
+are cloned; existing repositories are updated using the entry's strategy
+(or the manifest-wide default).
+
+Manifest format:
+  root: ~/workspace            # optional, defaults to the manifest directory
+  strategy: rebase             # default strategy: rebase, reset, clone, skip, pull, fetch
+  parallel: 8                  # optional default parallelism
+  repositories:
+    - url: https://github.com/user/api.git
+      path: services/api       # optional, defaults to the repository name
+      branch: develop          # optional
+      strategy: pull           # optional, overrides the default
+      depth: 1                 # optional shallow clone
+      groups: [backend]        # optional group membership
+  groups:                      # optional path-pattern groups
+    frontend: ["web/*"]
+
+Use --group and --not-group to sync only part of the workspace.`,
+	Example: `  # Sync the workspace described by ./.gz-git.yaml
+  gz-git sync
+
+  # Sync using a specific manifest
+  gz-git sync ~/workspace/team.yaml
+
+  # Preview what would be cloned or updated
+  gz-git sync --dry-run
+
+  # Process more repositories in parallel
+  gz-git sync -j 10
+
+  # Sync only the backend group
+  gz-git sync --group backend`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSync,
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+
+	syncCmd.Flags().IntVarP(&syncParallel, "parallel", "j", 0, "number of parallel operations (default: manifest value or 5)")
+	syncCmd.Flags().BoolVarP(&syncDryRun, "dry-run", "n", false, "show what would be done without doing it")
+	syncCmd.Flags().BoolVarP(&syncForce, "force", "f", false, "replace non-repository directories at target paths")
+	syncCmd.Flags().StringVar(&syncFormat, "format", formatDefault, bulkFormatHelp)
+	addHostLimitFlag(syncCmd, &syncHostLimits)
+	syncCmd.Flags().StringSliceVar(&syncGroups, "group", nil, "only sync repositories in these groups (repeatable)")
+	syncCmd.Flags().StringSliceVar(&syncExcludeGroups, "not-group", nil, "skip repositories in these groups (repeatable)")
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Validate format
+	if err := validateBulkFormat(syncFormat); err != nil {
+		return err
+	}
+
+	// Parse per-host concurrency limits
+	hostLimits, err := repository.ParseHostLimits(syncHostLimits)
+	if err != nil {
+		return err
+	}
+
+	manifestPath := repository.DefaultWorkspaceManifest
+	if len(args) > 0 {
+		manifestPath = args[0]
+	}
+
+	manifest, err := repository.LoadWorkspaceManifest(manifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to load manifest: %w", err)
+	}
+
+	// Create client
+	client := repository.NewClient()
+
+	progress := newBulkProgress("Syncing", syncFormat)
+
+	// Build options
+	opts := repository.SyncOptions{
+		Manifest:              manifest,
+		Parallel:              syncParallel,
+		HostLimits:            hostLimits,
+		DryRun:                syncDryRun,
+		Force:                 syncForce,
+		Groups:                syncGroups,
+		ExcludeGroups:         syncExcludeGroups,
+		Logger:                createBulkLogger(verbose),
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	// Stream one record per repository as it completes
+	if syncFormat == repository.OutputFormatNDJSON {
+		opts.ResultCallback = func(r repository.RepositorySyncResult) { streamRecord(r.Record()) }
+	}
+
+	if humanOutput(syncFormat) {
+		target := fmt.Sprintf("%d repositories", len(manifest.Repositories))
+		if len(syncGroups) > 0 || len(syncExcludeGroups) > 0 {
+			target = "selected groups"
+		}
+		if syncDryRun {
+			fmt.Printf("Syncing %s into %s [DRY-RUN]...\n", target, manifest.Root)
+		} else {
+			fmt.Printf("Syncing %s into %s...\n", target, manifest.Root)
+		}
+	}
+
+	result, err := client.Sync(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("sync failed: %w", err)
+	}
+
+	// Record the run so repositories reset by the sync can be undone
+	report := result.Report()
+	recordRun(result.Root, &BulkCommandFlags{DryRun: syncDryRun}, report)
+
+	if isMachineFormat(syncFormat) {
+		if err := writeBulkReport(syncFormat, report); err != nil {
+			return err
+		}
+	} else if !quiet {
+		displaySyncResults(result)
+	}
+
+	if count := result.Summary[repository.StatusError]; count > 0 {
+		return fmt.Errorf("%d %s failed to sync", count, repository.PluralSuffix(count, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+func displaySyncResults(result *repository.SyncResult) {
+	fmt.Println()
+	fmt.Println("=== Sync Results ===")
+	fmt.Printf("Total processed: %d repositories\n", result.TotalProcessed)
+	fmt.Printf("Duration:        %s\n", result.Duration.Round(100_000_000)) // Round to 0.1s
+	fmt.Println()
+
+	// Display summary
+	if len(result.Summary) > 0 {
+		fmt.Println("Summary by status:")
+		for status, count := range result.Summary {
+			fmt.Printf("  %s %-15s %d\n", getSyncStatusIcon(status), status+":", count)
+		}
+		fmt.Println()
+	}
+
+	// Display individual results if not compact, otherwise only failures
+	if len(result.Repositories) > 0 {
+		if syncFormat != "compact" {
+			fmt.Println("Repository details:")
+		}
+		for _, repo := range result.Repositories {
+			if syncFormat == "compact" && repo.Status != repository.StatusError {
+				continue
+			}
+			displaySyncRepositoryResult(repo)
+		}
+	}
+}
+
+func displaySyncRepositoryResult(repo repository.RepositorySyncResult) {
+	pathPart := repo.RelativePath
+	if repo.Branch != "" {
+		pathPart += fmt.Sprintf(" (%s)", repo.Branch)
+	}
+
+	fmt.Printf("  %s %-50s %-14s %6s\n", getSyncStatusIcon(repo.Status), pathPart, repo.Status, repo.Duration.Round(10_000_000))
+
+	// Show error details if present
+	if repo.Error != nil {
+		fmt.Printf("    Error: %v\n", repo.Error)
+	} else if verbose && repo.Message != "" {
+		fmt.Printf("    %s\n", repo.Message)
+	}
+}
+
+// getSyncStatusIcon returns the icon for a sync status.
+// Icons: ✓ (cloned/updated), ⊘ (skipped), → (dry-run), ✗ (error)
+func getSyncStatusIcon(status string) string {
+	switch {
+	case status == repository.StatusSkipped:
+		return "⊘"
+	case repository.IsDryRunStatus(status):
+		return "→"
+	case repository.IsSuccessStatus(status):
+		return "✓"
+	case repository.IsErrorStatus(status):
+		return "✗"
+	default:
+		return "•"
+	}
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+var (
+	undoDryRun   bool
+	undoForce    bool
+	undoYes      bool
+	undoList     bool
+	undoParallel int
+)
+
+// undoCmd represents the undo command
+var undoCmd = &cobra.Command{
+	Use:   "undo [run-id]",
+	Short: "Restore repositories to their state before a pull, switch or reset",
+	Long: `Restore every repository of a recorded run to its state before the run.
+
+Before 'pull', 'multi switch', 'sync' and 'update --strategy reset' change a
+repository, gz-git records its branch, HEAD commit and any stash it creates in
+the run journal. 'gz-git undo' checks out that branch, resets it to that commit
+and re-applies the stashed changes.
+
+Without a run ID, the most recent run that can be undone is used.
+Local changes made since the run are kept. Repositories that moved on since
+the run (new commits, another branch) are skipped unless --force is given.`,
+	Example: `  # Show recent runs that can be undone
+  gz-git undo --list
+
+  # Preview undoing the last pull or switch
+  gz-git undo --dry-run
+
+  # Undo a specific run
+  gz-git undo 20240115-103000-a1b2c3`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUndo,
+}
+
+func init() {
+	rootCmd.AddCommand(undoCmd)
+
+	undoCmd.Flags().BoolVarP(&undoDryRun, "dry-run", "n", false, "show what would be restored without doing it")
+	undoCmd.Flags().BoolVarP(&undoForce, "force", "f", false, "restore repositories that changed since the run")
+	undoCmd.Flags().BoolVarP(&undoYes, "yes", "y", false, "restore without asking for confirmation")
+	undoCmd.Flags().BoolVar(&undoList, "list", false, "list recent runs that can be undone")
+	undoCmd.Flags().IntVarP(&undoParallel, "parallel", "j", repository.DefaultBulkParallel, "number of parallel operations")
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	journal, err := repository.NewRunJournal("")
+	if err != nil {
+		return err
+	}
+
+	if undoList {
+		return displayUndoableRuns(journal)
+	}
+
+	var run *repository.Run
+	if len(args) > 0 {
+		run, err = journal.Load(args[0])
+	} else {
+		run, err = journal.LastUndoable()
+	}
+	if err != nil {
+		return err
+	}
+	if !run.Undoable() {
+		return fmt.Errorf("run %s (%s) recorded no state to restore", run.ID, run.Operation)
+	}
+
+	client := repository.NewClient()
+	progress := newBulkProgress("Restoring", formatDefault)
+
+	opts := repository.UndoOptions{
+		Run:                   run,
+		Parallel:              undoParallel,
+		DryRun:                undoDryRun,
+		Force:                 undoForce,
+		Logger:                createBulkLogger(verbose),
+		ProgressCallback:      progress.Callback(),
+		ProgressEventCallback: progress.EventCallback(),
+	}
+
+	fmt.Printf("Run %s: %s in %s (%s)\n", run.ID, run.Operation, run.Directory, run.CreatedAt.Format(time.DateTime))
+
+	// Preview first, so the user confirms what will actually be restored
+	if !undoDryRun && !undoYes {
+		preview := opts
+		preview.DryRun = true
+		preview.ProgressCallback = nil
+		preview.ProgressEventCallback = nil
+		result, err := client.Undo(ctx, preview)
+		if err != nil {
+			return fmt.Errorf("undo failed: %w", err)
+		}
+		pending := result.Summary[repository.StatusWouldRestore]
+		if pending == 0 {
+			displayUndoResults(result)
+			return nil
+		}
+		if !confirmPrompt(fmt.Sprintf("Restore %d repositories?", pending)) {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	result, err := client.Undo(ctx, opts)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("undo failed: %w", err)
+	}
+
+	if !quiet {
+		displayUndoResults(result)
+	}
+
+	if failed := result.Summary[repository.StatusError]; failed > 0 {
+		return fmt.Errorf("failed to restore %d %s", failed, repository.PluralSuffix(failed, "repository", "repositories"))
+	}
+
+	return nil
+}
+
+// displayUndoableRuns lists recent runs that can be undone, newest first
+func displayUndoableRuns(journal *repository.RunJournal) error {
+	runs, err := journal.List()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, run := range runs {
+		if !run.Undoable() {
+			continue
+		}
+		found = true
+		fmt.Printf("%-24s %-10s %-19s %4d repos  %s\n",
+			run.ID, run.Operation, run.CreatedAt.Format(time.DateTime), len(run.Repositories), run.Directory)
+	}
+	if !found {
+		fmt.Println("No runs that can be undone")
+	}
+
+	return nil
+}
+
+// displayUndoResults displays the results of an undo
+func displayUndoResults(result *repository.UndoResult) {
+	fmt.Println()
+	for _, repo := range result.Repositories {
+		var icon string
+		switch repo.Status {
+		case repository.StatusRestored:
+			icon = "✓"
+		case repository.StatusWouldRestore:
+			icon = "~"
+		case repository.StatusSkipped:
+			icon = "="
+		case repository.StatusConflict, repository.StatusRebaseInProgress, repository.StatusMergeInProgress:
+			icon = "⚠"
+		default:
+			icon = "✗"
+		}
+
+		fmt.Printf("[%s] %-40s %s\n", icon, repo.RelativePath, repo.Message)
+		if repo.Error != nil && verbose {
+			fmt.Printf("    Error: %v\n", repo.Error)
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d restored, %d would restore, %d skipped, %d failed\n",
+		result.Summary[repository.StatusRestored],
+		result.Summary[repository.StatusWouldRestore],
+		result.Summary[repository.StatusSkipped],
+		result.Summary[repository.StatusError])
+	fmt.Printf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+}
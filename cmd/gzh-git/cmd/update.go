@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -23,7 +24,7 @@ and used as the directory name (similar to 'git clone' behavior).
 
 Available Strategies:
   rebase  - Rebase local changes on top of remote changes (default)
-  reset   - Hard reset to match remote state (discards local changes)
+  reset   - Hard reset to match remote state (local changes are stashed; undo with 'gz-git undo')
   clone   - Remove existing directory and perform fresh clone
   skip    - Leave existing repository unchanged
   pull    - Standard git pull (merge remote changes)
@@ -39,7 +40,7 @@ Examples:
   # Force fresh clone by removing existing directory
   gz-git update --strategy clone https://github.com/user/repo.git
 
-  # Update existing repository with hard reset (discard local changes)
+  # Update existing repository with hard reset (stashing local changes)
   gz-git update --strategy reset https://github.com/user/repo.git ./repo
 
   # Skip existing repositories (useful for automation)
@@ -49,7 +50,11 @@ Examples:
   gz-git update --branch develop --depth 1 https://github.com/user/repo.git
 
   # Create branch if it doesn't exist on remote
-  gz-git update --branch develop --create-branch https://github.com/user/repo.git`,
+  gz-git update --branch develop --create-branch https://github.com/user/repo.git
+
+  # Clone or update a sparse checkout of two directories
+  # (an existing checkout is switched to this set by rebase, pull and reset)
+  gz-git update --sparse services/api,libs/common https://github.com/user/monorepo.git`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runUpdate,
 }
@@ -61,6 +66,7 @@ var updateOpts struct {
 	force        bool
 	verbose      bool
 	createBranch bool
+	sparse       []string
 	batch        bool
 }
 
@@ -77,6 +83,8 @@ func init() {
 		"Enable verbose logging")
 	updateCmd.Flags().BoolVarP(&updateOpts.createBranch, "create-branch", "c", false,
 		"Create branch if it doesn't exist on remote (only effective with --branch)")
+	updateCmd.Flags().StringSliceVar(&updateOpts.sparse, "sparse", nil,
+		"Check out only these directories (cone-mode sparse checkout, comma-separated); kept in sync on update")
 	updateCmd.Flags().BoolVar(&updateOpts.batch, "batch", false,
 		"Batch mode: suppress usage message on errors (for use in scripts/automation)")
 
@@ -120,6 +128,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		Depth:        updateOpts.depth,
 		Force:        updateOpts.force,
 		CreateBranch: updateOpts.createBranch,
+		SparsePaths:  updateOpts.sparse,
 		Logger:       logger,
 	}
 
@@ -133,6 +142,9 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		if opts.Depth > 0 {
 			fmt.Printf("Depth: %d\n", opts.Depth)
 		}
+		if len(opts.SparsePaths) > 0 {
+			fmt.Printf("Sparse: %s\n", strings.Join(opts.SparsePaths, ", "))
+		}
 	}
 
 	// Execute clone-or-update
@@ -167,5 +179,16 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("✓ %s\n", result.Message)
 	}
 
+	// Record the reset so it can be undone
+	if result.Undo != nil {
+		run, err := repository.NewRepositoryRun("update", opts.Destination, repository.StatusReset, result.Message, result.Undo)
+		if err == nil && saveRun(run) != nil {
+			if result.Undo.Stash != "" {
+				fmt.Println("  Local changes were saved in the stash")
+			}
+			fmt.Printf("  Undo with: gz-git undo %s\n", run.ID)
+		}
+	}
+
 	return nil
 }
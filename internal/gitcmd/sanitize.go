@@ -39,6 +39,14 @@ var safeGitFlags = map[string]bool{
 	"--recursive":        true,
 	"--shallow-since":    true,
 	"--shallow-exclude":  true,
+	"--sparse":           true,
+	"--mirror":           true,
+
+	// Sparse checkout flags
+	"--cone": true,
+
+	// Config flags
+	"--get-regexp": true,
 
 	// Status flags
 	"--porcelain":       true,
@@ -124,6 +132,33 @@ var safeGitFlags = map[string]bool{
 	// Rev-list flags
 	"--left-right": true,
 	"--count":      true,
+
+	// Maintenance flags
+	"--aggressive":     true,
+	"--expire":         true,
+	"--reachable":      true,
+	"--git-common-dir": true,
+
+	// Health check flags
+	"--set-upstream-to":       true,
+	"--is-shallow-repository": true,
+	"--auto":                  true,
+
+	// Secret scanning flags
+	"--patch":       true,
+	"--cc":          true,
+	"--unified":     true,
+	"--no-color":    true,
+	"--no-ext-diff": true,
+	"--not":         true,
+	"--remotes":     true,
+
+	// Large-file check flags
+	"--objects":              true,
+	"--filter":               true,
+	"--filter-print-omitted": true,
+	"--raw":                  true,
+	"--no-abbrev":            true,
 }
 
 // SanitizeArgs validates and sanitizes Git command arguments.
@@ -0,0 +1,66 @@
+package gitcmd
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Error output that indicates a temporary network or server problem.
+// Matched case-insensitively against Git's stderr.
+var transientPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`could not resolve host`),                     // DNS failure (HTTP and SSH)
+	regexp.MustCompile(`temporary failure in name resolution`),       // DNS failure
+	regexp.MustCompile(`timed out`),                                  // Connection or operation timeout
+	regexp.MustCompile(`connection reset`),                           // Connection dropped
+	regexp.MustCompile(`connection refused`),                         // Server unavailable
+	regexp.MustCompile(`failed to connect to`),                       // Server unreachable
+	regexp.MustCompile(`network is unreachable`),                     // Network down
+	regexp.MustCompile(`remote end hung up`),                         // Connection closed by server
+	regexp.MustCompile(`early eof`),                                  // Transfer interrupted
+	regexp.MustCompile(`unexpected disconnect`),                      // Transfer interrupted
+	regexp.MustCompile(`(?:http|error:?|status code:?)\s*5\d\d\b`),   // HTTP 5xx
+	regexp.MustCompile(`\b5\d\d\s+(?:internal|bad gateway|service)`), // HTTP 5xx reason phrase
+}
+
+// Error output that indicates a failure retrying will not fix.
+// These take precedence over transient patterns, as Git often reports
+// e.g. "remote end hung up" after an authentication failure.
+var permanentPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`authentication failed`),
+	regexp.MustCompile(`permission denied`),
+	regexp.MustCompile(`repository not found`),
+	regexp.MustCompile(`does not appear to be a git repository`),
+	regexp.MustCompile(`\[rejected\]`),
+	regexp.MustCompile(`(?:http|error:?|status code:?)\s*4\d\d\b`),
+}
+
+// IsTransient reports whether err is a Git failure that is likely to succeed
+// when retried, such as a DNS failure, timeout or HTTP 5xx response.
+// Only *GitError values are classified; any other error is permanent.
+func IsTransient(err error) bool {
+	var gitErr *GitError
+	if !errors.As(err, &gitErr) {
+		return false
+	}
+	return IsTransientOutput(gitErr.Stderr)
+}
+
+// IsTransientOutput reports whether Git error output describes a
+// temporary network or server failure.
+func IsTransientOutput(stderr string) bool {
+	output := strings.ToLower(stderr)
+
+	for _, pattern := range permanentPatterns {
+		if pattern.MatchString(output) {
+			return false
+		}
+	}
+	for _, pattern := range transientPatterns {
+		if pattern.MatchString(output) {
+			return true
+		}
+	}
+
+	return false
+}
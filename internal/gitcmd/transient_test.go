@@ -0,0 +1,56 @@
+package gitcmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIsTransientOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   bool
+	}{
+		{"dns http", "fatal: unable to access 'https://git.example.com/a.git/': Could not resolve host: git.example.com", true},
+		{"dns ssh", "ssh: Could not resolve hostname git.example.com: Name or service not known\nfatal: Could not read from remote repository.", true},
+		{"timeout", "ssh: connect to host git.example.com port 22: Connection timed out", true},
+		{"hung up", "fatal: the remote end hung up unexpectedly", true},
+		{"early eof", "fatal: early EOF\nfatal: index-pack failed", true},
+		{"http 502", "error: RPC failed; HTTP 502 curl 22 The requested URL returned error: 502", true},
+		{"http 503", "fatal: unable to access 'https://git.example.com/a.git/': The requested URL returned error: 503", true},
+		{"auth", "remote: Invalid username or password.\nfatal: Authentication failed for 'https://git.example.com/a.git/'", false},
+		{"ssh key", "git@git.example.com: Permission denied (publickey).\nfatal: Could not read from remote repository.", false},
+		{"not found", "remote: Repository not found.\nfatal: repository 'https://git.example.com/a.git/' not found", false},
+		{"http 403", "fatal: unable to access 'https://git.example.com/a.git/': The requested URL returned error: 403", false},
+		{"auth then hang up", "fatal: Authentication failed\nfatal: the remote end hung up unexpectedly", false},
+		{"push rejected", " ! [rejected]        main -> main (fetch first)\nerror: failed to push some refs", false},
+		{"not a repository", "fatal: 'origin' does not appear to be a git repository", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientOutput(tt.stderr); got != tt.want {
+				t.Errorf("IsTransientOutput(%q) = %v, want %v", tt.stderr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	gitErr := &GitError{Command: "git fetch", ExitCode: 128, Stderr: "fatal: the remote end hung up unexpectedly"}
+
+	if !IsTransient(gitErr) {
+		t.Error("IsTransient should classify a GitError by its stderr")
+	}
+	if !IsTransient(fmt.Errorf("fetch failed: %w", gitErr)) {
+		t.Error("IsTransient should unwrap wrapped errors")
+	}
+	if IsTransient(errors.New("the remote end hung up unexpectedly")) {
+		t.Error("IsTransient should only classify GitError values")
+	}
+	if IsTransient(nil) {
+		t.Error("IsTransient(nil) should be false")
+	}
+}
@@ -17,6 +17,12 @@ type CleanupService interface {
 
 	// Execute performs cleanup based on report.
 	Execute(ctx context.Context, repo *repository.Repository, report *CleanupReport, opts ExecuteOptions) error
+
+	// AnalyzeAll analyzes branches for cleanup in every discovered repository.
+	AnalyzeAll(ctx context.Context, opts BulkCleanupOptions) (*BulkCleanupReport, error)
+
+	// ExecuteAll performs cleanup based on a bulk report.
+	ExecuteAll(ctx context.Context, report *BulkCleanupReport, opts BulkExecuteOptions) (*BulkCleanupResult, error)
 }
 
 // cleanupService implements CleanupService.
@@ -0,0 +1,349 @@
+package branch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// Cleanup reasons reported in BranchCleanupResult.
+const (
+	ReasonMerged   = "merged"
+	ReasonStale    = "stale"
+	ReasonOrphaned = "orphaned"
+)
+
+// AnalyzeAll analyzes branches for cleanup in every discovered repository.
+func (c *cleanupService) AnalyzeAll(ctx context.Context, opts BulkCleanupOptions) (*BulkCleanupReport, error) {
+	startTime := time.Now()
+
+	client := opts.Client
+	if client == nil {
+		client = repository.NewClient()
+	}
+
+	parallel := opts.Parallel
+	if parallel <= 0 {
+		parallel = repository.DefaultBulkParallel
+	}
+
+	discovered, err := client.DiscoverRepositories(ctx, repository.DiscoverOptions{
+		Directory:         opts.Directory,
+		MaxDepth:          opts.MaxDepth,
+		IncludeSubmodules: opts.IncludeSubmodules,
+		IncludePattern:    opts.IncludePattern,
+		ExcludePattern:    opts.ExcludePattern,
+		Groups:            opts.Groups,
+		ExcludeGroups:     opts.ExcludeGroups,
+		Workspace:         opts.Workspace,
+		Logger:            opts.Logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]RepositoryCleanupReport, len(discovered.Repositories))
+	events := repository.NewProgressEvents(opts.ProgressEventCallback, len(discovered.Repositories))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallel)
+
+	for i, path := range discovered.Repositories {
+		i, path := i, path // capture loop variables
+
+		g.Go(func() error {
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(discovered.Repositories), path)
+			}
+			events.Started(i+1, path)
+			repoStart := time.Now()
+
+			reports[i] = RepositoryCleanupReport{
+				Path:         path,
+				RelativePath: repository.RelativePath(discovered.Directory, path),
+			}
+
+			// Each repository detects its own base branch unless one is given
+			report, err := c.Analyze(gctx, &repository.Repository{Path: path}, opts.Analyze)
+			if err != nil {
+				reports[i].Error = err // Don't fail entire operation on single repo error
+			} else {
+				reports[i].Report = report
+			}
+			events.Finished(i+1, path, reports[i].Record(nil).Status, time.Since(repoStart))
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &BulkCleanupReport{
+		Directory:    discovered.Directory,
+		TotalScanned: discovered.TotalScanned,
+		Repositories: reports,
+		Duration:     time.Since(startTime),
+	}, nil
+}
+
+// ExecuteAll performs cleanup based on a bulk report.
+// Branches are deleted one at a time within a repository and repositories are
+// processed in parallel. A failed deletion is recorded and does not stop the others.
+func (c *cleanupService) ExecuteAll(ctx context.Context, report *BulkCleanupReport, opts BulkExecuteOptions) (*BulkCleanupResult, error) {
+	if report == nil {
+		return nil, fmt.Errorf("cleanup report cannot be nil")
+	}
+
+	startTime := time.Now()
+
+	parallel := opts.Parallel
+	if parallel <= 0 {
+		parallel = repository.DefaultBulkParallel
+	}
+
+	// Only repositories with something to delete
+	pending := make([]RepositoryCleanupReport, 0)
+	for _, repo := range report.Repositories {
+		if repo.Report != nil && !repo.Report.IsEmpty() {
+			pending = append(pending, repo)
+		}
+	}
+
+	results := make([]RepositoryCleanupResult, len(pending))
+	events := repository.NewProgressEvents(opts.ProgressEventCallback, len(pending))
+	var mu sync.Mutex
+	deleted, failed := 0, 0
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallel)
+
+	for i, repo := range pending {
+		i, repo := i, repo // capture loop variables
+
+		g.Go(func() error {
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(pending), repo.Path)
+			}
+			events.Started(i+1, repo.Path)
+			repoStart := time.Now()
+
+			result := c.executeRepository(gctx, repo, opts.ExecuteOptions)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			for _, b := range result.Branches {
+				if b.Deleted {
+					deleted++
+				}
+				if b.Error != nil {
+					failed++
+				}
+			}
+			events.Finished(i+1, repo.Path, repo.Record(&result).Status, time.Since(repoStart))
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &BulkCleanupResult{
+		Repositories: results,
+		Deleted:      deleted,
+		Failed:       failed,
+		Duration:     time.Since(startTime),
+	}, nil
+}
+
+// executeRepository deletes the branches selected for a single repository.
+func (c *cleanupService) executeRepository(ctx context.Context, repo RepositoryCleanupReport, opts ExecuteOptions) RepositoryCleanupResult {
+	result := RepositoryCleanupResult{
+		Path:         repo.Path,
+		RelativePath: repo.RelativePath,
+		Branches:     make([]BranchCleanupResult, 0, repo.Report.CountBranches()),
+	}
+
+	target := &repository.Repository{Path: repo.Path}
+	for _, candidate := range cleanupCandidates(repo.Report) {
+		branch := candidate.branch
+		if c.isProtectedBranch(branch.Name, opts.Exclude) {
+			continue
+		}
+
+		branchResult := BranchCleanupResult{
+			Name:   branch.Name,
+			Reason: candidate.reason,
+			Remote: opts.Remote && branch.Upstream != "",
+		}
+
+		if !opts.DryRun {
+			// The branch was selected by analysis, so there is nothing left to confirm;
+			// unmerged branches still need Force because git refuses "branch -d".
+			err := c.branchManager.Delete(ctx, target, DeleteOptions{
+				Name:    branch.Name,
+				Force:   opts.Force,
+				Remote:  branchResult.Remote,
+				Confirm: true,
+			})
+			if err != nil {
+				// The local branch may be gone even if deleting its upstream failed
+				exists, existsErr := c.branchManager.Exists(ctx, target, branch.Name)
+				branchResult.Deleted = existsErr == nil && !exists
+				branchResult.Remote = false
+				branchResult.Error = err
+			} else {
+				branchResult.Deleted = true
+			}
+		}
+
+		result.Branches = append(result.Branches, branchResult)
+	}
+
+	return result
+}
+
+// cleanupCandidate is a branch selected for deletion and the reason it was selected.
+type cleanupCandidate struct {
+	branch *Branch
+	reason string
+}
+
+// cleanupCandidates lists the branches of a report in deletion order.
+func cleanupCandidates(report *CleanupReport) []cleanupCandidate {
+	candidates := make([]cleanupCandidate, 0, report.CountBranches())
+	for _, b := range report.Merged {
+		candidates = append(candidates, cleanupCandidate{b, ReasonMerged})
+	}
+	for _, b := range report.Stale {
+		candidates = append(candidates, cleanupCandidate{b, ReasonStale})
+	}
+	for _, b := range report.Orphaned {
+		candidates = append(candidates, cleanupCandidate{b, ReasonOrphaned})
+	}
+	return candidates
+}
+
+// CountBranches returns the total number of branches eligible for cleanup.
+func (r *BulkCleanupReport) CountBranches() int {
+	count := 0
+	for _, repo := range r.Repositories {
+		if repo.Report != nil {
+			count += repo.Report.CountBranches()
+		}
+	}
+	return count
+}
+
+// CountRepositories returns the number of repositories with branches eligible for cleanup.
+func (r *BulkCleanupReport) CountRepositories() int {
+	count := 0
+	for _, repo := range r.Repositories {
+		if repo.Report != nil && !repo.Report.IsEmpty() {
+			count++
+		}
+	}
+	return count
+}
+
+// Record returns the machine-readable form of the analysis of the repository
+// and, if result is not nil, of the deletions made in it. Without a result,
+// selected branches are reported as would-delete.
+func (r RepositoryCleanupReport) Record(result *RepositoryCleanupResult) repository.CleanupRecord {
+	record := repository.CleanupRecord{
+		Merged:   []string{},
+		Stale:    []string{},
+		Orphaned: []string{},
+		Deleted:  []string{},
+		Failed:   []string{},
+	}
+	status, message := repository.StatusClean, "nothing to clean up"
+	var err error
+
+	switch {
+	case r.Error != nil || r.Report == nil:
+		status, message, err = repository.StatusError, "analysis failed", r.Error
+	case !r.Report.IsEmpty():
+		record.Merged = branchNames(r.Report.Merged)
+		record.Stale = branchNames(r.Report.Stale)
+		record.Orphaned = branchNames(r.Report.Orphaned)
+
+		// Without a result, or after a dry run, nothing was deleted
+		count := r.Report.CountBranches()
+		status = repository.StatusWouldDelete
+		message = fmt.Sprintf("%d %s to delete", count, repository.PluralSuffix(count, "branch", "branches"))
+		if result == nil {
+			break
+		}
+
+		var errs []error
+		for _, b := range result.Branches {
+			if b.Deleted {
+				record.Deleted = append(record.Deleted, b.Name)
+			}
+			if b.Error != nil {
+				record.Failed = append(record.Failed, b.Name)
+				errs = append(errs, fmt.Errorf("%s: %w", b.Name, b.Error))
+			}
+		}
+		deleted := len(record.Deleted)
+		switch {
+		case len(errs) > 0:
+			status, err = repository.StatusError, errors.Join(errs...)
+			message = fmt.Sprintf("deleted %d of %d branches", deleted, len(result.Branches))
+		case deleted > 0:
+			status = repository.StatusDeleted
+			message = fmt.Sprintf("deleted %d %s", deleted, repository.PluralSuffix(deleted, "branch", "branches"))
+		case len(result.Branches) == 0:
+			// Every selected branch was excluded at execution time
+			status, message = repository.StatusClean, "nothing to clean up"
+		}
+	}
+
+	record.RecordBase = repository.NewRecordBase(r.Path, r.RelativePath, status, message, err, 0)
+	return record
+}
+
+// Report returns the machine-readable form of the analysis and, if result is
+// not nil, of the deletions made from it.
+func (r *BulkCleanupReport) Report(result *BulkCleanupResult) *repository.Report {
+	executed := make(map[string]*RepositoryCleanupResult)
+	duration := r.Duration
+	if result != nil {
+		for i := range result.Repositories {
+			executed[result.Repositories[i].Path] = &result.Repositories[i]
+		}
+		duration += result.Duration
+	}
+
+	records := make([]repository.CleanupRecord, len(r.Repositories))
+	summary := make(map[string]int)
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record(executed[repo.Path])
+		summary[records[i].Status]++
+	}
+
+	return repository.NewCleanupReport(r.TotalScanned, len(r.Repositories), duration, summary, records)
+}
+
+// branchNames returns the names of branches.
+func branchNames(branches []*Branch) []string {
+	names := make([]string, len(branches))
+	for i, b := range branches {
+		names[i] = b.Name
+	}
+	return names
+}
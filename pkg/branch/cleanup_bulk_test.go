@@ -0,0 +1,143 @@
+package branch
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+func TestCleanupService_ExecuteAll_NilReport(t *testing.T) {
+	svc := NewCleanupService()
+
+	if _, err := svc.ExecuteAll(context.Background(), nil, BulkExecuteOptions{}); err == nil {
+		t.Error("ExecuteAll() with nil report should return error")
+	}
+}
+
+func TestBulkCleanupReport_Counts(t *testing.T) {
+	report := &BulkCleanupReport{
+		Repositories: []RepositoryCleanupReport{
+			{RelativePath: "api", Report: &CleanupReport{Merged: []*Branch{{Name: "a"}}, Stale: []*Branch{{Name: "b"}}}},
+			{RelativePath: "web", Report: &CleanupReport{}},
+			{RelativePath: "broken", Error: os.ErrNotExist},
+		},
+	}
+
+	if got := report.CountBranches(); got != 2 {
+		t.Errorf("CountBranches() = %d, want 2", got)
+	}
+	if got := report.CountRepositories(); got != 1 {
+		t.Errorf("CountRepositories() = %d, want 1", got)
+	}
+
+	// Without an execution result, selected branches would be deleted
+	summary := report.Report(nil).Summary
+	if summary[repository.StatusWouldDelete] != 1 || summary[repository.StatusClean] != 1 || summary[repository.StatusError] != 1 {
+		t.Errorf("Report(nil) summary = %v", summary)
+	}
+}
+
+// TestIntegration_CleanupService_AnalyzeAll tests bulk analysis and deletion with real git repositories.
+func TestIntegration_CleanupService_AnalyzeAll(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	workDir := t.TempDir()
+	apiDir := filepath.Join(workDir, "api")
+	webDir := filepath.Join(workDir, "web")
+	for _, dir := range []string{apiDir, webDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+		initTestGitRepo(t, dir)
+	}
+
+	// api has a merged branch and an unmerged one
+	for _, args := range [][]string{
+		{"branch", "feature/done"},
+		{"checkout", "-b", "feature/wip"},
+		{"commit", "--allow-empty", "-m", "Work in progress"},
+		{"checkout", "-"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = apiDir
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("Failed to run git %v: %v\nOutput: %s", args, err, output)
+		}
+	}
+
+	ctx := context.Background()
+	svc := NewCleanupService()
+
+	report, err := svc.AnalyzeAll(ctx, BulkCleanupOptions{
+		Directory: workDir,
+		Analyze:   AnalyzeOptions{IncludeMerged: true, IncludeStale: true, StaleThreshold: time.Nanosecond},
+	})
+	if err != nil {
+		t.Fatalf("AnalyzeAll() error = %v", err)
+	}
+	if len(report.Repositories) != 2 {
+		t.Fatalf("AnalyzeAll() found %d repositories, want 2", len(report.Repositories))
+	}
+	if report.CountBranches() != 2 || report.CountRepositories() != 1 {
+		t.Fatalf("expected 2 branches in 1 repository, got %d in %d", report.CountBranches(), report.CountRepositories())
+	}
+
+	// Dry run deletes nothing
+	result, err := svc.ExecuteAll(ctx, report, BulkExecuteOptions{ExecuteOptions: ExecuteOptions{DryRun: true}})
+	if err != nil {
+		t.Fatalf("ExecuteAll() dry-run error = %v", err)
+	}
+	if result.Deleted != 0 || len(result.Repositories) != 1 || len(result.Repositories[0].Branches) != 2 {
+		t.Fatalf("unexpected dry-run result: %+v", result)
+	}
+	if summary := report.Report(result).Summary; summary[repository.StatusWouldDelete] != 1 || summary[repository.StatusClean] != 1 {
+		t.Errorf("unexpected dry-run report summary: %v", summary)
+	}
+
+	// Without force the unmerged branch is kept
+	var streamed []string
+	result, err = svc.ExecuteAll(ctx, report, BulkExecuteOptions{
+		ResultCallback: func(r RepositoryCleanupResult) { streamed = append(streamed, r.RelativePath) },
+	})
+	if err != nil {
+		t.Fatalf("ExecuteAll() error = %v", err)
+	}
+	if result.Deleted != 1 || result.Failed != 1 {
+		t.Fatalf("expected 1 deleted and 1 failed, got %+v", result.Repositories)
+	}
+	if len(streamed) != 1 || streamed[0] != "api" {
+		t.Errorf("ResultCallback called for %v, want [api]", streamed)
+	}
+
+	// The report lists the selection and the outcome per repository
+	for _, record := range report.Report(result).Repositories {
+		got := record.(repository.CleanupRecord)
+		switch got.RelativePath {
+		case "api":
+			if got.Status != repository.StatusError || len(got.Deleted) != 1 || got.Deleted[0] != "feature/done" ||
+				len(got.Failed) != 1 || got.Failed[0] != "feature/wip" || got.Error == "" {
+				t.Errorf("api: unexpected record %+v", got)
+			}
+		case "web":
+			if got.Status != repository.StatusClean || got.Deleted == nil || got.Merged == nil {
+				t.Errorf("web: unexpected record %+v", got)
+			}
+		}
+	}
+
+	mgr := NewManager()
+	repo := &repository.Repository{Path: apiDir}
+	if exists, _ := mgr.Exists(ctx, repo, "feature/done"); exists {
+		t.Error("merged branch should have been deleted")
+	}
+	if exists, _ := mgr.Exists(ctx, repo, "feature/wip"); !exists {
+		t.Error("unmerged branch should have been kept")
+	}
+}
@@ -182,9 +182,13 @@ func (m *manager) Delete(ctx context.Context, repo *repository.Repository, opts
 		deleteFlag = "-D"
 	}
 
-	if _, err := m.executor.Run(ctx, repo.Path, "branch", deleteFlag, opts.Name); err != nil {
+	result, err := m.executor.Run(ctx, repo.Path, "branch", deleteFlag, opts.Name)
+	if err != nil {
 		return fmt.Errorf("failed to delete branch: %w", err)
 	}
+	if result.ExitCode != 0 {
+		return fmt.Errorf("failed to delete branch: %s", strings.TrimSpace(result.Stderr))
+	}
 
 	// Delete remote branch if requested
 	if opts.Remote {
@@ -195,9 +199,13 @@ func (m *manager) Delete(ctx context.Context, repo *repository.Repository, opts
 				remote := parts[0]
 				remoteBranch := strings.Join(parts[1:], "/")
 
-				if _, err := m.executor.Run(ctx, repo.Path, "push", remote, "--delete", remoteBranch); err != nil {
+				result, err := m.executor.Run(ctx, repo.Path, "push", remote, "--delete", remoteBranch)
+				if err != nil {
 					return fmt.Errorf("failed to delete remote branch: %w", err)
 				}
+				if result.ExitCode != 0 {
+					return fmt.Errorf("failed to delete remote branch: %s", strings.TrimSpace(result.Stderr))
+				}
 			}
 		}
 	}
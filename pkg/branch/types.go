@@ -1,6 +1,10 @@
 package branch
 
-import "time"
+import (
+	"time"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
 
 // Branch represents a Git branch with metadata.
 type Branch struct {
@@ -190,6 +194,79 @@ type CleanupReport struct {
 	Total     int       // Total branches analyzed
 }
 
+// BulkCleanupOptions configures branch cleanup analysis across repositories.
+type BulkCleanupOptions struct {
+	Directory         string                        // Root directory to scan (default: current directory)
+	MaxDepth          int                           // Directory depth to scan
+	Parallel          int                           // Repositories analyzed in parallel
+	IncludeSubmodules bool                          // Include nested repositories and submodules
+	IncludePattern    string                        // Regex pattern for repositories to include
+	ExcludePattern    string                        // Regex pattern for repositories to exclude
+	Groups            []string                      // Workspace groups to include
+	ExcludeGroups     []string                      // Workspace groups to exclude
+	Workspace         *repository.WorkspaceManifest // Manifest defining the groups (optional)
+	Analyze           AnalyzeOptions                // Per-repository analysis options
+	Client            repository.Client             // Client used for discovery (default: repository.NewClient())
+	Logger            repository.Logger             // Logger for operation feedback
+	ProgressCallback  func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes analysis.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event repository.ProgressEvent)
+}
+
+// RepositoryCleanupReport is the cleanup analysis of a single repository.
+type RepositoryCleanupReport struct {
+	Path         string         // Absolute repository path
+	RelativePath string         // Path relative to the scanned directory
+	Report       *CleanupReport // Analysis result (nil if analysis failed)
+	Error        error          // Analysis error, if any
+}
+
+// BulkCleanupReport summarizes branches eligible for cleanup across repositories.
+type BulkCleanupReport struct {
+	Directory    string                    // Absolute path that was scanned
+	TotalScanned int                       // Repositories found before filtering
+	Repositories []RepositoryCleanupReport // Per-repository analysis, in scan order
+	Duration     time.Duration             // Time taken by the analysis
+}
+
+// BulkExecuteOptions configures branch cleanup execution across repositories.
+type BulkExecuteOptions struct {
+	ExecuteOptions
+	Parallel         int // Repositories cleaned up in parallel
+	ProgressCallback func(current, total int, repo string)
+	ResultCallback   func(result RepositoryCleanupResult) // Called as each repository completes
+
+	// ProgressEventCallback is called when a repository starts and finishes cleanup.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event repository.ProgressEvent)
+}
+
+// BranchCleanupResult is the outcome of deleting a single branch.
+type BranchCleanupResult struct {
+	Name    string // Branch name
+	Reason  string // Why the branch was selected (merged, stale, orphaned)
+	Deleted bool   // Branch was deleted
+	Remote  bool   // Upstream branch was deleted as well
+	Error   error  // Deletion error, if any
+}
+
+// RepositoryCleanupResult is the outcome of cleaning up a single repository.
+type RepositoryCleanupResult struct {
+	Path         string                // Absolute repository path
+	RelativePath string                // Path relative to the scanned directory
+	Branches     []BranchCleanupResult // Per-branch results
+}
+
+// BulkCleanupResult summarizes branch deletions across repositories.
+type BulkCleanupResult struct {
+	Repositories []RepositoryCleanupResult // Repositories that had branches to delete
+	Deleted      int                       // Branches deleted
+	Failed       int                       // Branches with a deletion error (local or remote)
+	Duration     time.Duration             // Time taken by the deletions
+}
+
 // CleanupStrategy defines cleanup approach.
 type CleanupStrategy string
 
@@ -0,0 +1,375 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// BulkCommitter commits the same change across many repositories.
+type BulkCommitter interface {
+	// Commit stages the selected paths and commits them in every discovered repository.
+	Commit(ctx context.Context, opts BulkCommitOptions) (*BulkCommitResult, error)
+}
+
+// BulkCommitOptions configures a bulk commit.
+type BulkCommitOptions struct {
+	Directory         string                        // Root directory to scan (default: current directory)
+	MaxDepth          int                           // Directory depth to scan
+	Parallel          int                           // Repositories committed in parallel
+	IncludeSubmodules bool                          // Include nested repositories and submodules
+	IncludePattern    string                        // Regex pattern for repositories to include
+	ExcludePattern    string                        // Regex pattern for repositories to exclude
+	Groups            []string                      // Workspace groups to include
+	ExcludeGroups     []string                      // Workspace groups to exclude
+	Workspace         *repository.WorkspaceManifest // Manifest defining the groups (optional)
+
+	Message        string    // Shared commit message (required unless Auto)
+	Auto           bool      // Generate a message per repository
+	Template       *Template // Template for generation and validation (default: conventional)
+	SkipValidation bool      // Do not validate messages against the template
+	Paths          []string  // Pathspecs to stage (default: all changes)
+	DryRun         bool      // Report what would be committed without committing
+
+	Client           repository.Client // Client used for discovery (default: repository.NewClient())
+	Logger           repository.Logger // Logger for operation feedback
+	ProgressCallback func(current, total int, repo string)
+	ResultCallback   func(result RepositoryCommitResult)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event repository.ProgressEvent)
+}
+
+// RepositoryCommitResult is the outcome of committing in a single repository.
+type RepositoryCommitResult struct {
+	Path          string        // Absolute repository path
+	RelativePath  string        // Path relative to the scanned directory
+	Status        string        // One of the repository.Status* values
+	Message       string        // Human-readable outcome
+	CommitMessage string        // Message used (or that would be used) for the commit
+	Commit        string        // Hash of the created commit
+	Files         int           // Number of files committed
+	Error         error         // Error, if any
+	Duration      time.Duration // Time taken
+}
+
+// BulkCommitResult summarizes a bulk commit.
+type BulkCommitResult struct {
+	TotalScanned   int                      // Repositories found before filtering
+	TotalProcessed int                      // Repositories processed
+	Repositories   []RepositoryCommitResult // Per-repository results, in scan order
+	Summary        map[string]int           // Count of repositories per status
+	Validation     *ValidationResult        // Validation of the shared message (nil with Auto or SkipValidation)
+	Duration       time.Duration            // Total time taken
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryCommitResult) Record() repository.CommitRecord {
+	return repository.CommitRecord{
+		RecordBase:    repository.NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Commit:        r.Commit,
+		CommitMessage: r.CommitMessage,
+		Files:         r.Files,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkCommitResult) Report() *repository.Report {
+	records := make([]repository.CommitRecord, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return repository.NewCommitReport(r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, records)
+}
+
+// bulkCommitter implements BulkCommitter.
+type bulkCommitter struct {
+	executor    *gitcmd.Executor
+	generator   Generator
+	validator   Validator
+	templateMgr TemplateManager
+}
+
+// NewBulkCommitter creates a new BulkCommitter.
+func NewBulkCommitter() BulkCommitter {
+	return &bulkCommitter{
+		executor:    gitcmd.NewExecutor(),
+		generator:   NewGenerator(),
+		validator:   NewValidator(),
+		templateMgr: NewTemplateManager(),
+	}
+}
+
+// NewBulkCommitterWithDeps creates a new BulkCommitter with custom dependencies.
+func NewBulkCommitterWithDeps(executor *gitcmd.Executor, generator Generator, validator Validator, templateMgr TemplateManager) BulkCommitter {
+	return &bulkCommitter{
+		executor:    executor,
+		generator:   generator,
+		validator:   validator,
+		templateMgr: templateMgr,
+	}
+}
+
+// Commit stages the selected paths and commits them in every discovered repository.
+// The shared message is validated once before any repository is touched; generated
+// messages are validated per repository. Repositories without changes in the selected
+// paths are reported as clean, and repositories with other staged changes are skipped
+// so that unrelated work is never committed.
+func (b *bulkCommitter) Commit(ctx context.Context, opts BulkCommitOptions) (*BulkCommitResult, error) {
+	startTime := time.Now()
+
+	// Validate options
+	if opts.Auto && opts.Message != "" {
+		return nil, fmt.Errorf("message and auto are mutually exclusive")
+	}
+	if !opts.Auto && strings.TrimSpace(opts.Message) == "" {
+		return nil, fmt.Errorf("commit message is required unless auto is set")
+	}
+	if len(opts.Paths) == 0 {
+		opts.Paths = []string{"."}
+	}
+	for _, path := range opts.Paths {
+		if path == "" || strings.HasPrefix(path, "-") {
+			return nil, fmt.Errorf("invalid pathspec: %q", path)
+		}
+	}
+
+	// Set defaults
+	if opts.Template == nil {
+		tmpl, err := b.templateMgr.Load(ctx, "conventional")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load default template: %w", err)
+		}
+		opts.Template = tmpl
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = repository.DefaultBulkParallel
+	}
+	client := opts.Client
+	if client == nil {
+		client = repository.NewClient()
+	}
+
+	// Validate the shared message before touching any repository
+	var validation *ValidationResult
+	if !opts.Auto && !opts.SkipValidation {
+		result, err := b.validator.Validate(ctx, opts.Message, opts.Template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate message: %w", err)
+		}
+		if !result.Valid {
+			return nil, fmt.Errorf("%w: %s", ErrValidationFailed, joinValidationErrors(result))
+		}
+		validation = result
+	}
+
+	discovered, err := client.DiscoverRepositories(ctx, repository.DiscoverOptions{
+		Directory:         opts.Directory,
+		MaxDepth:          opts.MaxDepth,
+		IncludeSubmodules: opts.IncludeSubmodules,
+		IncludePattern:    opts.IncludePattern,
+		ExcludePattern:    opts.ExcludePattern,
+		Groups:            opts.Groups,
+		ExcludeGroups:     opts.ExcludeGroups,
+		Workspace:         opts.Workspace,
+		Logger:            opts.Logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RepositoryCommitResult, len(discovered.Repositories))
+	events := repository.NewProgressEvents(opts.ProgressEventCallback, len(discovered.Repositories))
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, path := range discovered.Repositories {
+		i, path := i, path // capture loop variables
+
+		g.Go(func() error {
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(discovered.Repositories), path)
+			}
+			events.Started(i+1, path)
+
+			result := b.commitRepository(gctx, discovered.Directory, path, opts)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, path, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	summary := make(map[string]int)
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return &BulkCommitResult{
+		TotalScanned:   discovered.TotalScanned,
+		TotalProcessed: len(results),
+		Repositories:   results,
+		Summary:        summary,
+		Validation:     validation,
+		Duration:       time.Since(startTime),
+	}, nil
+}
+
+// commitRepository stages and commits the selected paths in a single repository.
+func (b *bulkCommitter) commitRepository(ctx context.Context, rootDir, path string, opts BulkCommitOptions) RepositoryCommitResult {
+	startTime := time.Now()
+
+	result := RepositoryCommitResult{
+		Path:         path,
+		RelativePath: repository.RelativePath(rootDir, path),
+	}
+	fail := func(err error) RepositoryCommitResult {
+		result.Status = repository.StatusError
+		result.Message = "commit failed"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	// Anything to commit in the selected paths?
+	changed, err := b.executor.RunLines(ctx, path, withPathspec([]string{"status", "--porcelain"}, opts.Paths)...)
+	if err != nil {
+		return fail(fmt.Errorf("failed to get status: %w", err))
+	}
+	if len(changed) == 0 {
+		result.Status = repository.StatusClean
+		result.Message = "nothing to commit"
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	// Never commit changes someone else staged outside the selected paths
+	staged, err := b.executor.RunLines(ctx, path, "diff", "--cached", "--name-only")
+	if err != nil {
+		return fail(fmt.Errorf("failed to list staged files: %w", err))
+	}
+	stagedInPaths, err := b.executor.RunLines(ctx, path, withPathspec([]string{"diff", "--cached", "--name-only"}, opts.Paths)...)
+	if err != nil {
+		return fail(fmt.Errorf("failed to list staged files: %w", err))
+	}
+	if outside := len(staged) - len(stagedInPaths); outside > 0 {
+		result.Status = repository.StatusSkipped
+		result.Message = fmt.Sprintf("%d staged %s outside the selected paths", outside, repository.PluralSuffix(outside, "file", "files"))
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	// Work out the message
+	repo := &repository.Repository{Path: path}
+	message := opts.Message
+	if opts.Auto {
+		message, err = b.generator.Generate(ctx, repo, GenerateOptions{Template: opts.Template, Paths: opts.Paths})
+		if err != nil {
+			return fail(fmt.Errorf("failed to generate commit message: %w", err))
+		}
+		if !opts.SkipValidation {
+			validation, err := b.validator.Validate(ctx, message, opts.Template)
+			if err != nil {
+				return fail(fmt.Errorf("failed to validate message: %w", err))
+			}
+			if !validation.Valid {
+				result.CommitMessage = message
+				return fail(fmt.Errorf("%w: %s", ErrValidationFailed, joinValidationErrors(validation)))
+			}
+		}
+	}
+	result.CommitMessage = message
+
+	if opts.DryRun {
+		result.Status = repository.StatusWouldCommit
+		result.Files = len(changed)
+		result.Message = fmt.Sprintf("would commit %d %s", len(changed), repository.PluralSuffix(len(changed), "file", "files"))
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	// Stage the selected paths
+	if _, err := b.executor.RunOutput(ctx, path, withPathspec([]string{"add", "-A"}, opts.Paths)...); err != nil {
+		return fail(fmt.Errorf("failed to stage changes: %w", err))
+	}
+	staged, err = b.executor.RunLines(ctx, path, "diff", "--cached", "--name-only")
+	if err != nil {
+		return fail(fmt.Errorf("failed to list staged files: %w", err))
+	}
+	if len(staged) == 0 {
+		// Only ignored or already committed content matched
+		result.Status = repository.StatusClean
+		result.Message = "nothing to commit"
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	// Commit from a file: multi-line messages cannot be passed as arguments
+	if err := b.commitWithMessage(ctx, path, message); err != nil {
+		return fail(fmt.Errorf("%w (changes remain staged)", err))
+	}
+
+	hash, err := b.executor.RunOutput(ctx, path, "rev-parse", "HEAD")
+	if err != nil {
+		return fail(fmt.Errorf("failed to read commit hash: %w", err))
+	}
+
+	result.Status = repository.StatusCommitted
+	result.Commit = hash
+	result.Files = len(staged)
+	result.Message = fmt.Sprintf("committed %d %s as %s", len(staged), repository.PluralSuffix(len(staged), "file", "files"), repository.ShortCommit(hash))
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// commitWithMessage creates a commit from the index using message.
+func (b *bulkCommitter) commitWithMessage(ctx context.Context, path, message string) error {
+	file, err := os.CreateTemp("", "gz-git-commit-*.txt")
+	if err != nil {
+		return fmt.Errorf("failed to create message file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(message + "\n"); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write message file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write message file: %w", err)
+	}
+
+	if _, err := b.executor.RunOutput(ctx, path, "commit", "-q", "-F", file.Name()); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	return nil
+}
+
+// joinValidationErrors joins the messages of all validation errors.
+func joinValidationErrors(result *ValidationResult) string {
+	messages := make([]string, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		messages = append(messages, e.Message)
+	}
+	return strings.Join(messages, "; ")
+}
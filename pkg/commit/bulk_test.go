@@ -0,0 +1,210 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// initBulkTestRepo creates a repository with an initial commit at path.
+func initBulkTestRepo(t *testing.T, path string) {
+	t.Helper()
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatalf("Failed to create %s: %v", path, err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "README.md"), []byte("# Test\n"), 0o644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test User"},
+		{"add", "."},
+		{"commit", "-m", "Initial commit"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = path
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Skipf("Skipping test: git %v failed: %v\n%s", args, err, output)
+		}
+	}
+}
+
+// gitOutput runs git in path and returns its trimmed output.
+func gitOutput(t *testing.T, path string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = path
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func TestBulkCommitter_InvalidOptions(t *testing.T) {
+	ctx := context.Background()
+	committer := NewBulkCommitter()
+	dir := t.TempDir()
+
+	tests := []struct {
+		name string
+		opts BulkCommitOptions
+	}{
+		{"no message", BulkCommitOptions{Directory: dir}},
+		{"message and auto", BulkCommitOptions{Directory: dir, Message: "chore: x", Auto: true}},
+		{"flag as pathspec", BulkCommitOptions{Directory: dir, Message: "chore: x", Paths: []string{"--all"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := committer.Commit(ctx, tt.opts); err == nil {
+				t.Error("Commit() should return error")
+			}
+		})
+	}
+}
+
+func TestBulkCommitter_RejectsInvalidMessage(t *testing.T) {
+	workDir := t.TempDir()
+	initBulkTestRepo(t, filepath.Join(workDir, "api"))
+	if err := os.WriteFile(filepath.Join(workDir, "api", "LICENSE"), []byte("MIT\n"), 0o644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	_, err := NewBulkCommitter().Commit(context.Background(), BulkCommitOptions{Directory: workDir, Message: "added license"})
+	if !errors.Is(err, ErrValidationFailed) {
+		t.Fatalf("Commit() error = %v, want ErrValidationFailed", err)
+	}
+	if status := gitOutput(t, filepath.Join(workDir, "api"), "status", "--porcelain"); status == "" {
+		t.Error("nothing should have been committed")
+	}
+}
+
+func TestBulkCommitter_Commit(t *testing.T) {
+	workDir := t.TempDir()
+	apiDir := filepath.Join(workDir, "api")
+	webDir := filepath.Join(workDir, "web")
+	docsDir := filepath.Join(workDir, "docs")
+	for _, dir := range []string{apiDir, webDir, docsDir} {
+		initBulkTestRepo(t, dir)
+	}
+
+	// api and web get a license file; web also has an unrelated change; docs has work staged elsewhere
+	for _, dir := range []string{apiDir, webDir, docsDir} {
+		if err := os.WriteFile(filepath.Join(dir, "LICENSE"), []byte("MIT\n"), 0o644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(webDir, "README.md"), []byte("# Changed\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(docsDir, "README.md"), []byte("# Staged\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+	gitOutput(t, docsDir, "add", "README.md")
+
+	ctx := context.Background()
+	committer := NewBulkCommitter()
+	opts := BulkCommitOptions{
+		Directory: workDir,
+		Message:   "chore: add license file\n\nSame license for every repository.",
+		Paths:     []string{"LICENSE"},
+	}
+	byPath := func(result *BulkCommitResult) map[string]RepositoryCommitResult {
+		m := make(map[string]RepositoryCommitResult)
+		for _, repo := range result.Repositories {
+			m[repo.RelativePath] = repo
+		}
+		return m
+	}
+
+	// Dry run commits nothing
+	dryRun := opts
+	dryRun.DryRun = true
+	result, err := committer.Commit(ctx, dryRun)
+	if err != nil {
+		t.Fatalf("Commit() dry-run error = %v", err)
+	}
+	if result.Summary[repository.StatusWouldCommit] != 2 || result.Summary[repository.StatusSkipped] != 1 {
+		t.Fatalf("unexpected dry-run summary: %v", result.Summary)
+	}
+	if count := gitOutput(t, apiDir, "rev-list", "--count", "HEAD"); count != "1" {
+		t.Fatalf("dry run created a commit (count %s)", count)
+	}
+
+	result, err = committer.Commit(ctx, opts)
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	repos := byPath(result)
+	for _, name := range []string{"api", "web"} {
+		got := repos[name]
+		if got.Status != repository.StatusCommitted || got.Files != 1 {
+			t.Fatalf("%s: expected one committed file, got %+v (%v)", name, got, got.Error)
+		}
+		if head := gitOutput(t, filepath.Join(workDir, name), "rev-parse", "HEAD"); got.Commit != head {
+			t.Errorf("%s: Commit = %s, want HEAD %s", name, got.Commit, head)
+		}
+	}
+	if got := repos["docs"].Status; got != repository.StatusSkipped {
+		t.Errorf("docs: expected skipped because of staged changes, got %s", got)
+	}
+
+	// The report carries the resulting commit of each repository
+	report := result.Report()
+	if report.Operation != "commit" || len(report.Repositories) != 3 {
+		t.Fatalf("unexpected report: %s with %d records", report.Operation, len(report.Repositories))
+	}
+	for _, record := range report.Repositories {
+		got := record.(repository.CommitRecord)
+		if want := repos[got.RelativePath]; got.Commit != want.Commit || got.Status != want.Status {
+			t.Errorf("%s: record %+v does not match result %+v", got.RelativePath, got, want)
+		}
+	}
+
+	// The full message is kept and only the selected path is committed
+	if body := gitOutput(t, webDir, "log", "-1", "--format=%B"); body != opts.Message {
+		t.Errorf("commit message = %q, want %q", body, opts.Message)
+	}
+	if status := gitOutput(t, webDir, "status", "--porcelain"); status != "M README.md" {
+		t.Errorf("unrelated change should be left alone, status = %q", status)
+	}
+
+	// Nothing left to commit in the selected paths
+	result, err = committer.Commit(ctx, opts)
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if got := byPath(result)["api"].Status; got != repository.StatusClean {
+		t.Errorf("api: expected clean on second run, got %s", got)
+	}
+}
+
+func TestBulkCommitter_Auto(t *testing.T) {
+	workDir := t.TempDir()
+	apiDir := filepath.Join(workDir, "api")
+	initBulkTestRepo(t, apiDir)
+	if err := os.WriteFile(filepath.Join(apiDir, "README.md"), []byte("# Docs\n\nMore docs.\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+
+	result, err := NewBulkCommitter().Commit(context.Background(), BulkCommitOptions{Directory: workDir, Auto: true})
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	got := result.Repositories[0]
+	if got.Status != repository.StatusCommitted {
+		t.Fatalf("expected committed, got %+v (%v)", got, got.Error)
+	}
+	if subject := gitOutput(t, apiDir, "log", "-1", "--format=%s"); subject != got.CommitMessage || !strings.HasPrefix(subject, "docs") {
+		t.Errorf("expected generated docs message, got %q (result %q)", subject, got.CommitMessage)
+	}
+}
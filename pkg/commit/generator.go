@@ -24,6 +24,7 @@ type GenerateOptions struct {
 	Template    *Template
 	Interactive bool // Ask user for clarifications
 	MaxLength   int
+	Paths       []string // Limit the analyzed changes to these pathspecs (default: all changes)
 }
 
 // DiffSummary summarizes git diff.
@@ -85,7 +86,7 @@ func (g *generator) Generate(ctx context.Context, repo *repository.Repository, o
 	}
 
 	// Get diff summary
-	summary, err := g.getDiffSummary(ctx, repo)
+	summary, err := g.getDiffSummary(ctx, repo, opts.Paths)
 	if err != nil {
 		return "", fmt.Errorf("failed to get diff summary: %w", err)
 	}
@@ -147,9 +148,9 @@ func (g *generator) Suggest(ctx context.Context, changes *DiffSummary) (*Suggest
 }
 
 // getDiffSummary gets a summary of uncommitted changes.
-func (g *generator) getDiffSummary(ctx context.Context, repo *repository.Repository) (*DiffSummary, error) {
+func (g *generator) getDiffSummary(ctx context.Context, repo *repository.Repository, paths []string) (*DiffSummary, error) {
 	// Get status to find changed files
-	result, err := g.executor.Run(ctx, repo.Path, "status", "--porcelain")
+	result, err := g.executor.Run(ctx, repo.Path, withPathspec([]string{"status", "--porcelain"}, paths)...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +191,7 @@ func (g *generator) getDiffSummary(ctx context.Context, repo *repository.Reposit
 	summary.FilesChanged = len(summary.AddedFiles) + len(summary.ModifiedFiles) + len(summary.DeletedFiles)
 
 	// Get insertions/deletions from diff --stat
-	diffResult, err := g.executor.Run(ctx, repo.Path, "diff", "--cached", "--stat")
+	diffResult, err := g.executor.Run(ctx, repo.Path, withPathspec([]string{"diff", "--cached", "--stat"}, paths)...)
 	if err == nil {
 		summary.Insertions, summary.Deletions = g.parseStats(diffResult.Stdout)
 	}
@@ -198,6 +199,14 @@ func (g *generator) getDiffSummary(ctx context.Context, repo *repository.Reposit
 	return summary, nil
 }
 
+// withPathspec appends paths to args after a "--" separator.
+func withPathspec(args, paths []string) []string {
+	if len(paths) == 0 {
+		return args
+	}
+	return append(append(args, "--"), paths...)
+}
+
 // inferType infers commit type from file changes.
 func (g *generator) inferType(changes *DiffSummary) (string, float64) {
 	allFiles := append(append(changes.ModifiedFiles, changes.AddedFiles...), changes.DeletedFiles...)
@@ -0,0 +1,280 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+// DefaultMaxFileSize is the default size limit for files in outgoing commits
+// (50 MiB, where hosting services start warning).
+const DefaultMaxFileSize int64 = 50 << 20
+
+// DefaultLFSPatterns are the default glob patterns of binaries that belong in Git LFS.
+var DefaultLFSPatterns = []string{
+	"*.zip", "*.tar", "*.gz", "*.tgz", "*.bz2", "*.xz", "*.7z", "*.rar",
+	"*.iso", "*.dmg", "*.exe", "*.dll", "*.so", "*.dylib", "*.jar", "*.war",
+	"*.psd", "*.ai", "*.sketch", "*.mp3", "*.mp4", "*.mov", "*.avi", "*.mkv",
+	"*.sqlite", "*.db", "*.dump", "*.bak",
+}
+
+// lfsPointerPrefix starts every Git LFS pointer file.
+const lfsPointerPrefix = "version https://git-lfs.github.com/spec/v1"
+
+// maxLFSPointerSize is the largest blob that is read to check for an LFS pointer.
+const maxLFSPointerSize = 1024
+
+// checkAttrBatchSize is the number of paths passed to a single check-attr call.
+const checkAttrBatchSize = 500
+
+// LargeFileGuard configures the large-file checks of SmartPush.
+type LargeFileGuard struct {
+	// MaxSize is the size limit in bytes (0: DefaultMaxFileSize, negative: no limit)
+	MaxSize int64
+
+	// LFSPatterns are glob patterns of files that should be stored in Git LFS,
+	// matched against the file name, or the full path if the pattern contains
+	// a '/' (nil: DefaultLFSPatterns). Files tracked by LFS in .gitattributes
+	// are always checked.
+	LFSPatterns []string
+}
+
+// LargeFile is a file in an outgoing commit that is too large or belongs in LFS.
+type LargeFile struct {
+	Path   string
+	Commit string // commit that introduced the blob
+	Size   int64
+
+	// OverLimit is set for files larger than MaxSize
+	OverLimit bool
+
+	// NotInLFS is set for files that match an LFS pattern but are not LFS pointers
+	NotInLFS bool
+
+	// LFSTracked is set if .gitattributes assigns the file to LFS
+	LFSTracked bool
+}
+
+// outgoingBlob is a blob added by an outgoing commit.
+type outgoingBlob struct {
+	path   string
+	commit string
+}
+
+// findLargeFiles inspects the blobs of the outgoing commits in revRange.
+func (p *smartPush) findLargeFiles(ctx context.Context, repo *repository.Repository, revRange []string) ([]LargeFile, error) {
+	guard := p.largeFiles
+	if guard.MaxSize == 0 {
+		guard.MaxSize = DefaultMaxFileSize
+	}
+	if guard.LFSPatterns == nil {
+		guard.LFSPatterns = DefaultLFSPatterns
+	}
+
+	blobs, order, err := p.outgoingBlobs(ctx, repo, revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects the push uploads; rev-list leaves out what is already on the
+	// remote, including blobs a merge brings in from an upstream branch
+	args := []string{"rev-list", "--objects"}
+	if guard.MaxSize > 0 {
+		args = append(args, "--filter-print-omitted", fmt.Sprintf("--filter=blob:limit=%d", guard.MaxSize+1))
+	}
+	lines, err := p.executor.RunLines(ctx, repo.Path, append(args, revRange...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outgoing objects: %w", err)
+	}
+
+	outgoing := map[string]bool{}
+	oversized := map[string]bool{}
+	for _, line := range lines {
+		hash, omitted := strings.CutPrefix(line, "~")
+		hash, _, _ = strings.Cut(hash, " ")
+		outgoing[hash] = true
+		if !omitted {
+			continue
+		}
+		oversized[hash] = true
+
+		// rev-list is authoritative; report blobs the log did not attribute
+		if _, seen := blobs[hash]; !seen {
+			blobs[hash] = outgoingBlob{path: "blob " + repository.ShortCommit(hash)}
+			order = append(order, hash)
+		}
+	}
+	order = slices.DeleteFunc(order, func(hash string) bool { return !outgoing[hash] })
+
+	// Files matching an LFS pattern or tracked by LFS in .gitattributes
+	var paths []string
+	for _, hash := range order {
+		if blobs[hash].commit != "" {
+			paths = append(paths, blobs[hash].path)
+		}
+	}
+	lfsTracked, err := p.lfsTrackedPaths(ctx, repo, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []LargeFile
+	for _, hash := range order {
+		blob := blobs[hash]
+		tracked := lfsTracked[blob.path]
+		lfsCandidate := tracked || matchesLFSPattern(blob.path, guard.LFSPatterns)
+		if !oversized[hash] && !lfsCandidate {
+			continue
+		}
+
+		size, err := p.blobSize(ctx, repo, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		file := LargeFile{
+			Path:       blob.path,
+			Commit:     blob.commit,
+			Size:       size,
+			OverLimit:  oversized[hash],
+			LFSTracked: tracked,
+		}
+		switch {
+		case !lfsCandidate || size == 0:
+		case size > maxLFSPointerSize:
+			file.NotInLFS = true
+		default:
+			content, err := p.executor.RunOutput(ctx, repo.Path, "cat-file", "blob", hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read blob %s: %w", repository.ShortCommit(hash), err)
+			}
+			file.NotInLFS = !strings.HasPrefix(content, lfsPointerPrefix)
+		}
+
+		if file.OverLimit || file.NotInLFS {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// outgoingBlobs lists the blobs added or changed by the commits in revRange,
+// with the path and the oldest commit that introduced each. Merge commits are
+// diffed against every parent, so the result can include blobs that are
+// already on the remote; callers narrow it down with rev-list.
+func (p *smartPush) outgoingBlobs(ctx context.Context, repo *repository.Repository, revRange []string) (map[string]outgoingBlob, []string, error) {
+	args := append([]string{"log", "--raw", "-m", "--no-abbrev", "-M", "--format=commit %H"}, revRange...)
+	lines, err := p.executor.RunLines(ctx, repo.Path, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list outgoing changes: %w", err)
+	}
+
+	blobs := map[string]outgoingBlob{}
+	var order []string
+	var commit string
+	for _, line := range lines {
+		if hash, ok := strings.CutPrefix(line, "commit "); ok {
+			commit = hash
+			continue
+		}
+
+		// :<old mode> <new mode> <old blob> <new blob> <status>\t<path>[\t<new path>]
+		meta, paths, ok := strings.Cut(line, "\t")
+		if !ok || !strings.HasPrefix(meta, ":") {
+			continue
+		}
+		fields := strings.Fields(meta)
+		if len(fields) < 5 {
+			continue
+		}
+		oldBlob, newBlob, status := fields[2], fields[3], fields[4]
+		if strings.HasPrefix(status, "D") || oldBlob == newBlob || strings.Trim(newBlob, "0") == "" {
+			continue
+		}
+		pathParts := strings.Split(paths, "\t")
+		filePath := unquotePath(pathParts[len(pathParts)-1])
+
+		// Commits are listed newest first; keep the oldest
+		if _, seen := blobs[newBlob]; !seen {
+			order = append(order, newBlob)
+		}
+		blobs[newBlob] = outgoingBlob{path: filePath, commit: commit}
+	}
+
+	return blobs, order, nil
+}
+
+// lfsTrackedPaths returns the paths that .gitattributes assigns to LFS.
+func (p *smartPush) lfsTrackedPaths(ctx context.Context, repo *repository.Repository, paths []string) (map[string]bool, error) {
+	tracked := map[string]bool{}
+
+	// Paths the argument sanitizer rejects are left out rather than failing the check
+	var safePaths []string
+	for _, filePath := range paths {
+		if _, err := gitcmd.SanitizeArgs([]string{filePath}); err == nil {
+			safePaths = append(safePaths, filePath)
+		}
+	}
+
+	for start := 0; start < len(safePaths); start += checkAttrBatchSize {
+		end := min(start+checkAttrBatchSize, len(safePaths))
+		args := append([]string{"check-attr", "filter", "--"}, safePaths[start:end]...)
+		lines, err := p.executor.RunLines(ctx, repo.Path, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check attributes: %w", err)
+		}
+
+		// <path>: filter: <value>
+		for _, line := range lines {
+			if filePath, ok := strings.CutSuffix(line, ": filter: lfs"); ok {
+				tracked[unquotePath(filePath)] = true
+			}
+		}
+	}
+
+	return tracked, nil
+}
+
+// blobSize returns the size of a blob in bytes.
+func (p *smartPush) blobSize(ctx context.Context, repo *repository.Repository, hash string) (int64, error) {
+	output, err := p.executor.RunOutput(ctx, repo.Path, "cat-file", "-s", hash)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get size of blob %s: %w", repository.ShortCommit(hash), err)
+	}
+	size, err := strconv.ParseInt(output, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected cat-file output: %s", output)
+	}
+	return size, nil
+}
+
+// matchesLFSPattern reports whether filePath matches one of the glob patterns.
+func matchesLFSPattern(filePath string, patterns []string) bool {
+	name := path.Base(filePath)
+	for _, pattern := range patterns {
+		target := name
+		if strings.Contains(pattern, "/") {
+			target = filePath
+		}
+		if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(target)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// commitLabel returns the short hash of commit, or "unknown" if no outgoing
+// commit introduced the file.
+func commitLabel(commit string) string {
+	if commit == "" {
+		return "unknown"
+	}
+	return repository.ShortCommit(commit)
+}
@@ -0,0 +1,174 @@
+package commit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
+
+func TestMatchesLFSPattern(t *testing.T) {
+	tests := []struct {
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"assets/logo.PSD", DefaultLFSPatterns, true},
+		{"backup/db.dump", DefaultLFSPatterns, true},
+		{"main.go", DefaultLFSPatterns, false},
+		{"data/train.csv", []string{"data/*.csv"}, true},
+		{"other/train.csv", []string{"data/*.csv"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesLFSPattern(tt.path, tt.patterns); got != tt.want {
+			t.Errorf("matchesLFSPattern(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSmartPush_CanPushLargeFiles(t *testing.T) {
+	dir := t.TempDir()
+	remote := filepath.Join(dir, "remote.git")
+	path := filepath.Join(dir, "repo")
+	initBulkTestRepo(t, path)
+	gitOutput(t, dir, "init", "--bare", remote)
+	gitOutput(t, path, "remote", "add", "origin", remote)
+
+	// A large file that is already on the remote is not reported again
+	writeFile(t, filepath.Join(path, "pushed.dat"), strings.Repeat("p", 4096))
+	writeFile(t, filepath.Join(path, ".gitattributes"), "*.bin filter=lfs diff=lfs merge=lfs -text\n")
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-m", "Add pushed data")
+	gitOutput(t, path, "push", "-u", "origin", "HEAD")
+
+	writeFile(t, filepath.Join(path, "dump.sql"), strings.Repeat("x", 4096))
+	writeFile(t, filepath.Join(path, "model.bin"), "raw model data")
+	writeFile(t, filepath.Join(path, "logo.psd"), "8BPS image data")
+	writeFile(t, filepath.Join(path, "archive.zip"), lfsPointerPrefix+"\noid sha256:0000\nsize 123\n")
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-m", "Add data")
+	introduced := gitOutput(t, path, "rev-parse", "HEAD")
+
+	gitOutput(t, path, "mv", "pushed.dat", "moved.dat")
+	gitOutput(t, path, "commit", "-m", "Move data")
+
+	repo := &repository.Repository{Path: path}
+	sp := NewSmartPushWithConfig(SmartPushConfig{LargeFiles: LargeFileGuard{MaxSize: 1024}})
+	check, err := sp.CanPush(context.Background(), repo)
+	if err != nil {
+		t.Fatalf("CanPush() error = %v", err)
+	}
+	if check.Safe {
+		t.Fatal("CanPush() = safe, want blocked by large file")
+	}
+
+	issues := map[string]PushIssue{}
+	for _, issue := range check.Issues {
+		if issue.File != "" {
+			issues[issue.File] = issue
+		}
+	}
+
+	if issue, ok := issues["dump.sql"]; !ok || !issue.Blocker || issue.Commit != introduced || !strings.Contains(issue.Message, "4.0 KiB") {
+		t.Errorf("dump.sql issue = %+v, want blocking issue in commit %s", issue, introduced)
+	}
+	if issue, ok := issues["model.bin"]; !ok || !issue.Blocker {
+		t.Errorf("model.bin issue = %+v, want blocking issue for LFS-tracked file", issue)
+	}
+	if issue, ok := issues["logo.psd"]; !ok || issue.Blocker || issue.Severity != "warning" {
+		t.Errorf("logo.psd issue = %+v, want LFS warning", issue)
+	}
+	for _, file := range []string{"archive.zip", "moved.dat", "pushed.dat"} {
+		if issue, ok := issues[file]; ok {
+			t.Errorf("unexpected issue for %s: %+v", file, issue)
+		}
+	}
+}
+
+func TestSmartPush_CanPushLargeFileInMerge(t *testing.T) {
+	dir := t.TempDir()
+	remote := filepath.Join(dir, "remote.git")
+	path := filepath.Join(dir, "repo")
+	initBulkTestRepo(t, path)
+	gitOutput(t, dir, "init", "--bare", remote)
+	gitOutput(t, path, "remote", "add", "origin", remote)
+	gitOutput(t, path, "push", "-u", "origin", "HEAD")
+
+	gitOutput(t, path, "checkout", "-q", "-b", "side")
+	writeFile(t, filepath.Join(path, "side.txt"), "side\n")
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-m", "Side change")
+	gitOutput(t, path, "checkout", "-q", "-")
+
+	// The large file only appears in the merge commit itself
+	gitOutput(t, path, "merge", "--no-ff", "--no-commit", "side")
+	writeFile(t, filepath.Join(path, "dump.sql"), strings.Repeat("x", 4096))
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "--no-edit")
+	merge := gitOutput(t, path, "rev-parse", "HEAD")
+
+	sp := NewSmartPushWithConfig(SmartPushConfig{LargeFiles: LargeFileGuard{MaxSize: 1024}})
+	check, err := sp.CanPush(context.Background(), &repository.Repository{Path: path})
+	if err != nil {
+		t.Fatalf("CanPush() error = %v", err)
+	}
+
+	var found bool
+	for _, issue := range check.Issues {
+		if issue.File == "dump.sql" && issue.Commit == merge && issue.Blocker {
+			found = true
+		}
+	}
+	if check.Safe || !found {
+		t.Errorf("CanPush() = %+v, want blocking issue for dump.sql in merge %s", check, merge)
+	}
+}
+
+func TestSmartPush_CanPushMergeOfPushedBinary(t *testing.T) {
+	dir := t.TempDir()
+	remote := filepath.Join(dir, "remote.git")
+	path := filepath.Join(dir, "repo")
+	initBulkTestRepo(t, path)
+	gitOutput(t, dir, "init", "--bare", remote)
+	gitOutput(t, path, "remote", "add", "origin", remote)
+	gitOutput(t, path, "push", "-u", "origin", "HEAD")
+	base := gitOutput(t, path, "rev-parse", "--abbrev-ref", "HEAD")
+
+	// A binary outside LFS that is already on the remote
+	writeFile(t, filepath.Join(path, "logo.psd"), "8BPS raw image data\n")
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-m", "Add logo")
+	gitOutput(t, path, "push", "origin", "HEAD")
+
+	// A feature branch from before the binary merges the pushed branch
+	gitOutput(t, path, "checkout", "-q", "-b", "feature", "HEAD~1")
+	writeFile(t, filepath.Join(path, "feature.txt"), "feature\n")
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-m", "Feature")
+	gitOutput(t, path, "merge", "--no-ff", "--no-edit", base)
+
+	check, err := NewSmartPush().CanPush(context.Background(), &repository.Repository{Path: path})
+	if err != nil {
+		t.Fatalf("CanPush() error = %v", err)
+	}
+	for _, issue := range check.Issues {
+		if issue.File == "logo.psd" {
+			t.Errorf("CanPush() reported already pushed binary: %+v", issue)
+		}
+	}
+	if !check.Safe {
+		t.Errorf("CanPush() = %+v, want safe", check.Issues)
+	}
+}
+
+// writeFile writes content to path.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
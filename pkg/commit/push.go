@@ -2,7 +2,10 @@ package commit
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
@@ -43,11 +46,25 @@ type PushIssue struct {
 	Severity string // error, warning, info
 	Message  string
 	Blocker  bool // Blocks push if true
+
+	// Location of the issue, for issues found in outgoing commits
+	File   string
+	Line   int
+	Commit string
+}
+
+// SmartPushConfig configures the checks of a SmartPush.
+type SmartPushConfig struct {
+	Executor   *gitcmd.Executor // nil: default executor
+	Secrets    *SecretScanner   // nil: default rules plus the repository's DefaultSecretConfigFile
+	LargeFiles LargeFileGuard
 }
 
 // smartPush implements SmartPush.
 type smartPush struct {
-	executor *gitcmd.Executor
+	executor   *gitcmd.Executor
+	secrets    *SecretScanner // nil: default rules plus the repository's DefaultSecretConfigFile
+	largeFiles LargeFileGuard
 }
 
 // NewSmartPush creates a new SmartPush.
@@ -64,6 +81,19 @@ func NewSmartPushWithExecutor(executor *gitcmd.Executor) SmartPush {
 	}
 }
 
+// NewSmartPushWithConfig creates a new SmartPush with custom checks.
+func NewSmartPushWithConfig(cfg SmartPushConfig) SmartPush {
+	executor := cfg.Executor
+	if executor == nil {
+		executor = gitcmd.NewExecutor()
+	}
+	return &smartPush{
+		executor:   executor,
+		secrets:    cfg.Secrets,
+		largeFiles: cfg.LargeFiles,
+	}
+}
+
 // Protected branches that should not accept force pushes
 var protectedBranches = map[string]bool{
 	"main":    true,
@@ -315,17 +345,172 @@ func (p *smartPush) CanPush(ctx context.Context, repo *repository.Repository) (*
 		}
 	}
 
-	// Check if pushing to protected branch
-	if protectedBranches[currentBranch] {
+	// Inspect outgoing commits
+	revRange, err := p.outgoingRange(ctx, repo, currentBranch, hasUpstream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine outgoing commits: %w", err)
+	}
+	if revRange == nil {
+		return p.checkProtectedBranch(check, currentBranch), nil
+	}
+
+	// Scan outgoing commits for secrets
+	findings, err := p.scanOutgoingCommits(ctx, repo, revRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan for secrets: %w", err)
+	}
+
+	for _, finding := range findings {
+		check.Safe = false
+		location := finding.File
+		if finding.Line > 0 {
+			location = fmt.Sprintf("%s:%d", finding.File, finding.Line)
+		}
+		message := fmt.Sprintf("possible %s in %s (commit %s)", finding.Description, location, repository.ShortCommit(finding.Commit))
+		if finding.Secret != "" {
+			message = fmt.Sprintf("possible %s %s in %s (commit %s)", finding.Description, finding.Secret, location, repository.ShortCommit(finding.Commit))
+		}
+		check.Issues = append(check.Issues, PushIssue{
+			Severity: "error",
+			Message:  message,
+			Blocker:  true,
+			File:     finding.File,
+			Line:     finding.Line,
+			Commit:   finding.Commit,
+		})
+	}
+
+	if len(findings) > 0 {
+		check.Recommendations = append(check.Recommendations,
+			"remove secrets from the outgoing commits and rotate them",
+			fmt.Sprintf("allowlist false positives in %s or mark the line with %q", DefaultSecretConfigFile, secretAllowMarker))
+	}
+
+	// Check outgoing commits for large files and binaries that belong in LFS
+	largeFiles, err := p.findLargeFiles(ctx, repo, revRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for large files: %w", err)
+	}
+
+	var overLimit, notInLFS bool
+	for _, file := range largeFiles {
+		issue := PushIssue{
+			File:   file.Path,
+			Commit: file.Commit,
+		}
+		switch {
+		case file.OverLimit:
+			overLimit = true
+			issue.Severity = "error"
+			issue.Blocker = true
+			issue.Message = fmt.Sprintf("%s is %s, over the %s limit (commit %s)",
+				file.Path, repository.FormatSize(file.Size), repository.FormatSize(p.maxFileSize()), commitLabel(file.Commit))
+		case file.LFSTracked:
+			notInLFS = true
+			issue.Severity = "error"
+			issue.Blocker = true
+			issue.Message = fmt.Sprintf("%s (%s) is tracked by LFS but was committed without it (commit %s)",
+				file.Path, repository.FormatSize(file.Size), commitLabel(file.Commit))
+		default:
+			notInLFS = true
+			issue.Severity = "warning"
+			issue.Message = fmt.Sprintf("binary %s (%s) should be stored in Git LFS (commit %s)",
+				file.Path, repository.FormatSize(file.Size), commitLabel(file.Commit))
+		}
+		if issue.Blocker {
+			check.Safe = false
+		}
+		check.Issues = append(check.Issues, issue)
+	}
+
+	if overLimit {
+		check.Recommendations = append(check.Recommendations,
+			"remove large files from the outgoing commits (git rm --cached, then amend or rebase) before pushing")
+	}
+	if notInLFS {
+		check.Recommendations = append(check.Recommendations,
+			"install Git LFS and use git lfs migrate import --include=<pattern> to move binaries out of the outgoing commits")
+	}
+
+	return p.checkProtectedBranch(check, currentBranch), nil
+}
+
+// checkProtectedBranch warns about pushing to a protected branch.
+func (p *smartPush) checkProtectedBranch(check *PushCheck, branch string) *PushCheck {
+	if protectedBranches[branch] {
 		check.Issues = append(check.Issues, PushIssue{
 			Severity: "warning",
-			Message:  fmt.Sprintf("pushing to protected branch '%s'", currentBranch),
+			Message:  fmt.Sprintf("pushing to protected branch '%s'", branch),
 			Blocker:  false,
 		})
 		check.Recommendations = append(check.Recommendations, "ensure you have proper authorization")
 	}
 
-	return check, nil
+	return check
+}
+
+// outgoingRange returns the revision arguments selecting the commits that a
+// push of branch would send: those not on its upstream, or not on any remote
+// if it has none. It returns nil for an unborn branch.
+func (p *smartPush) outgoingRange(ctx context.Context, repo *repository.Repository, branch string, hasUpstream bool) ([]string, error) {
+	verify, err := p.executor.Run(ctx, repo.Path, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	if err != nil {
+		return nil, err
+	}
+	if verify.ExitCode != 0 {
+		return nil, nil
+	}
+
+	if hasUpstream {
+		return []string{branch + "@{upstream}.." + branch, "--"}, nil
+	}
+	return []string{branch, "--not", "--remotes", "--"}, nil
+}
+
+// maxFileSize returns the effective size limit of the large-file check.
+func (p *smartPush) maxFileSize() int64 {
+	if p.largeFiles.MaxSize == 0 {
+		return DefaultMaxFileSize
+	}
+	return p.largeFiles.MaxSize
+}
+
+// scanOutgoingCommits scans the commits in revRange for secrets. Merge
+// commits are shown as combined diffs, so lines added while resolving a merge
+// are scanned, but content merged in from an already pushed branch is not.
+func (p *smartPush) scanOutgoingCommits(ctx context.Context, repo *repository.Repository, revRange []string) ([]SecretFinding, error) {
+	scanner, err := p.secretScanner(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append([]string{"log", "--patch", "--cc", "--unified=0", "--no-color", "--no-ext-diff", "--format=commit %H"}, revRange...)
+	result, err := p.executor.Run(ctx, repo.Path, args...)
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("git log failed: %s", strings.TrimSpace(result.Stderr))
+	}
+
+	return scanner.ScanPatch(strings.NewReader(result.Stdout))
+}
+
+// secretScanner returns the configured scanner, or one built from the
+// default rules and the repository's rule file.
+func (p *smartPush) secretScanner(repo *repository.Repository) (*SecretScanner, error) {
+	if p.secrets != nil {
+		return p.secrets, nil
+	}
+
+	cfg, err := LoadSecretConfig(filepath.Join(repo.Path, DefaultSecretConfigFile))
+	if errors.Is(err, os.ErrNotExist) {
+		cfg = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return NewSecretScanner(cfg)
 }
 
 // getCurrentBranch gets the current branch name.
@@ -0,0 +1,463 @@
+package commit
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultSecretConfigFile is the secret scanning rule file that SmartPush
+// loads from the repository root when present.
+const DefaultSecretConfigFile = ".gz-git-secrets.yaml"
+
+// secretAllowMarker on an added line suppresses findings on that line.
+const secretAllowMarker = "gz-git:allow-secret"
+
+// SecretRule describes a kind of secret.
+type SecretRule struct {
+	ID          string  `yaml:"id"`
+	Description string  `yaml:"description"`
+	Pattern     string  `yaml:"pattern"`  // regex matched against added lines; group 1, if any, is the secret
+	Path        string  `yaml:"path"`     // regex of file paths the pattern applies to; a rule with only a path flags the file itself
+	Entropy     float64 `yaml:"entropy"`  // minimum Shannon entropy of the secret in bits per character
+	Disabled    bool    `yaml:"disabled"` // turns off a default rule with the same ID
+
+	pattern *regexp.Regexp
+	path    *regexp.Regexp
+}
+
+// SecretAllowlist lists known false positives.
+type SecretAllowlist struct {
+	Paths    []string `yaml:"paths"`    // regexes of file paths that are not scanned
+	Patterns []string `yaml:"patterns"` // regexes of secrets that are not reported, e.g. test fixtures
+	Commits  []string `yaml:"commits"`  // commits that are not scanned (full or abbreviated hashes)
+}
+
+// SecretConfig is the content of a secret scanning rule file.
+//
+// Rules are added to the default rules; a rule with the ID of a default rule
+// replaces it. With ReplaceDefaults, only the rules and allowlist of the file
+// are used.
+type SecretConfig struct {
+	ReplaceDefaults bool            `yaml:"replace_defaults"`
+	Rules           []SecretRule    `yaml:"rules"`
+	Allowlist       SecretAllowlist `yaml:"allowlist"`
+}
+
+// SecretFinding is a likely secret in an outgoing commit.
+type SecretFinding struct {
+	RuleID      string
+	Description string
+	Commit      string
+	File        string
+	Line        int    // 0 if the rule flags the whole file
+	Secret      string // redacted
+}
+
+// DefaultSecretRules returns the built-in secret scanning rules.
+func DefaultSecretRules() []SecretRule {
+	return []SecretRule{
+		{
+			ID:          "private-key",
+			Description: "private key",
+			Pattern:     `-----BEGIN[ A-Z0-9_-]*PRIVATE KEY( BLOCK)?-----`,
+		},
+		{
+			ID:          "aws-access-key-id",
+			Description: "AWS access key ID",
+			Pattern:     `\b((?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16})\b`,
+		},
+		{
+			ID:          "aws-secret-access-key",
+			Description: "AWS secret access key",
+			Pattern:     `(?i)aws_?secret_?access_?key['"]?\s*[:=]\s*['"]?([A-Za-z0-9/+=]{40})`,
+			Entropy:     3.5,
+		},
+		{
+			ID:          "github-token",
+			Description: "GitHub token",
+			Pattern:     `\b(gh[pousr]_[A-Za-z0-9]{36,255})\b`,
+		},
+		{
+			ID:          "slack-token",
+			Description: "Slack token",
+			Pattern:     `\b(xox[abprs]-[A-Za-z0-9-]{10,})`,
+		},
+		{
+			ID:          "generic-secret",
+			Description: "high-entropy secret",
+			Pattern:     `(?i)(?:api[_-]?key|secret|token|passw(?:or)?d|credentials?)[A-Za-z0-9_-]*['"]?\s*[:=]\s*['"]?([A-Za-z0-9_\-+/=.]{20,})`,
+			Entropy:     4.0,
+		},
+		{
+			ID:          "env-file",
+			Description: "environment file",
+			Path:        `(^|/)\.env(\.[^/]+)?$`,
+		},
+	}
+}
+
+// defaultSecretAllowlist skips example files, which hold placeholders.
+func defaultSecretAllowlist() SecretAllowlist {
+	return SecretAllowlist{
+		Paths: []string{`(^|/)\.env\.(example|sample|template|dist)$`},
+	}
+}
+
+// LoadSecretConfig loads a secret scanning rule file.
+func LoadSecretConfig(path string) (*SecretConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret rules: %w", err)
+	}
+
+	var cfg SecretConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse secret rules %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// SecretScanner finds likely secrets in patches.
+type SecretScanner struct {
+	rules         []SecretRule
+	allowPaths    []*regexp.Regexp
+	allowPatterns []*regexp.Regexp
+	allowCommits  []string
+}
+
+// NewSecretScanner creates a SecretScanner from the default rules and cfg,
+// which may be nil.
+func NewSecretScanner(cfg *SecretConfig) (*SecretScanner, error) {
+	rules := DefaultSecretRules()
+	allowlist := defaultSecretAllowlist()
+
+	if cfg != nil {
+		if cfg.ReplaceDefaults {
+			rules = nil
+			allowlist = SecretAllowlist{}
+		}
+		for _, rule := range cfg.Rules {
+			rules = mergeSecretRule(rules, rule)
+		}
+		allowlist.Paths = append(allowlist.Paths, cfg.Allowlist.Paths...)
+		allowlist.Patterns = append(allowlist.Patterns, cfg.Allowlist.Patterns...)
+		allowlist.Commits = append(allowlist.Commits, cfg.Allowlist.Commits...)
+	}
+
+	s := &SecretScanner{allowCommits: allowlist.Commits}
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		if err := compileSecretRule(&rule); err != nil {
+			return nil, err
+		}
+		s.rules = append(s.rules, rule)
+	}
+
+	var err error
+	if s.allowPaths, err = compilePatterns(allowlist.Paths); err != nil {
+		return nil, fmt.Errorf("invalid allowlist path: %w", err)
+	}
+	if s.allowPatterns, err = compilePatterns(allowlist.Patterns); err != nil {
+		return nil, fmt.Errorf("invalid allowlist pattern: %w", err)
+	}
+
+	return s, nil
+}
+
+// mergeSecretRule adds rule to rules, replacing a rule with the same ID.
+func mergeSecretRule(rules []SecretRule, rule SecretRule) []SecretRule {
+	for i := range rules {
+		if rules[i].ID == rule.ID {
+			rules[i] = rule
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+// compileSecretRule validates rule and compiles its patterns.
+func compileSecretRule(rule *SecretRule) error {
+	if rule.ID == "" {
+		return fmt.Errorf("secret rule without id")
+	}
+	if rule.Pattern == "" && rule.Path == "" {
+		return fmt.Errorf("secret rule %s: pattern or path is required", rule.ID)
+	}
+
+	var err error
+	if rule.Pattern != "" {
+		if rule.pattern, err = regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("secret rule %s: invalid pattern: %w", rule.ID, err)
+		}
+	}
+	if rule.Path != "" {
+		if rule.path, err = regexp.Compile(rule.Path); err != nil {
+			return fmt.Errorf("secret rule %s: invalid path: %w", rule.ID, err)
+		}
+	}
+	if rule.Description == "" {
+		rule.Description = rule.ID
+	}
+
+	return nil
+}
+
+// compilePatterns compiles a list of regular expressions.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// ScanPatch scans the output of "git log --patch --cc --unified=0 --format='commit %H'"
+// and returns the likely secrets in added lines and added or modified files.
+// In the combined diffs of merge commits, only lines that are new to every
+// parent count as added, so content merged from either side is not rescanned.
+func (s *SecretScanner) ScanPatch(r io.Reader) ([]SecretFinding, error) {
+	var (
+		findings []SecretFinding
+		commit   string
+		file     string
+		deleted  bool
+		inHeader bool
+		lineNo   int
+		parents  = 1 // diff columns: one per parent in combined diffs
+	)
+
+	// endHeader applies the path rules once the file of a diff is known
+	endHeader := func() {
+		if inHeader && !deleted && file != "" && !s.skipCommit(commit) && !s.skipPath(file) {
+			findings = append(findings, s.scanPath(commit, file)...)
+		}
+		inHeader = false
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if line == "" && err != nil {
+			break
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case strings.HasPrefix(line, "commit "):
+			endHeader()
+			commit = strings.TrimSpace(strings.TrimPrefix(line, "commit "))
+			file = ""
+		case strings.HasPrefix(line, "diff --git "):
+			endHeader()
+			inHeader = true
+			deleted = false
+			file = diffGitPath(line)
+			parents = 1
+		case strings.HasPrefix(line, "diff --cc "):
+			endHeader()
+			inHeader = true
+			deleted = false
+			file = unquotePath(strings.TrimPrefix(line, "diff --cc "))
+		case inHeader && strings.HasPrefix(line, "deleted file mode"):
+			deleted = true
+		case inHeader && strings.HasPrefix(line, "+++ "):
+			if name := strings.TrimPrefix(line, "+++ "); name == "/dev/null" {
+				deleted = true
+			} else {
+				file = strings.TrimPrefix(unquotePath(name), "b/")
+			}
+		case strings.HasPrefix(line, "@@"):
+			endHeader()
+			// "@@ " for a diff, "@@@ " for a combined diff against two parents, ...
+			parents = max(len(line)-len(strings.TrimLeft(line, "@"))-1, 1)
+			lineNo = hunkStartLine(line)
+		case !inHeader && len(line) >= parents && strings.Trim(line[:parents], "+- ") == "":
+			// Lines removed from any parent are not in the result
+			if marks := line[:parents]; !strings.Contains(marks, "-") {
+				if strings.Count(marks, "+") == parents && file != "" && !deleted && !s.skipCommit(commit) && !s.skipPath(file) {
+					findings = append(findings, s.scanLine(commit, file, lineNo, line[parents:])...)
+				}
+				lineNo++
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+	endHeader()
+
+	return findings, nil
+}
+
+// scanPath applies the rules that only match file paths.
+func (s *SecretScanner) scanPath(commit, file string) []SecretFinding {
+	var findings []SecretFinding
+	for _, rule := range s.rules {
+		if rule.pattern == nil && rule.path.MatchString(file) {
+			findings = append(findings, SecretFinding{
+				RuleID:      rule.ID,
+				Description: rule.Description,
+				Commit:      commit,
+				File:        file,
+			})
+		}
+	}
+	return findings
+}
+
+// scanLine applies the pattern rules to an added line.
+func (s *SecretScanner) scanLine(commit, file string, lineNo int, line string) []SecretFinding {
+	if strings.Contains(line, secretAllowMarker) {
+		return nil
+	}
+
+	var findings []SecretFinding
+	for _, rule := range s.rules {
+		if rule.pattern == nil || (rule.path != nil && !rule.path.MatchString(file)) {
+			continue
+		}
+		match := rule.pattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		secret := match[0]
+		if len(match) > 1 && match[1] != "" {
+			secret = match[1]
+		}
+		if rule.Entropy > 0 && shannonEntropy(secret) < rule.Entropy {
+			continue
+		}
+		if s.allowedSecret(secret) {
+			continue
+		}
+		findings = append(findings, SecretFinding{
+			RuleID:      rule.ID,
+			Description: rule.Description,
+			Commit:      commit,
+			File:        file,
+			Line:        lineNo,
+			Secret:      redactSecret(secret),
+		})
+	}
+	return findings
+}
+
+// skipCommit reports whether commit is allowlisted.
+func (s *SecretScanner) skipCommit(commit string) bool {
+	for _, allowed := range s.allowCommits {
+		if len(allowed) >= 7 && strings.HasPrefix(commit, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipPath reports whether file is allowlisted.
+func (s *SecretScanner) skipPath(file string) bool {
+	for _, re := range s.allowPaths {
+		if re.MatchString(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedSecret reports whether secret is allowlisted.
+func (s *SecretScanner) allowedSecret(secret string) bool {
+	for _, re := range s.allowPatterns {
+		if re.MatchString(secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffGitPath extracts the new path from a "diff --git a/<old> b/<new>" line.
+func diffGitPath(line string) string {
+	rest := strings.TrimPrefix(line, "diff --git ")
+	if strings.HasPrefix(rest, `"`) {
+		// Quoted paths are handled by the "+++" line
+		return ""
+	}
+	if idx := strings.LastIndex(rest, " b/"); idx != -1 {
+		return rest[idx+3:]
+	}
+	return ""
+}
+
+// unquotePath decodes a path git quoted because of special characters.
+func unquotePath(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			return unquoted
+		}
+	}
+	return path
+}
+
+// hunkStartLine returns the first new line number of a "@@ -a,b +c,d @@" hunk header,
+// or of a combined "@@@ -a,b -c,d +e,f @@@" one.
+func hunkStartLine(header string) int {
+	for _, field := range strings.Fields(header) {
+		if !strings.HasPrefix(field, "+") {
+			continue
+		}
+		start, _, _ := strings.Cut(strings.TrimPrefix(field, "+"), ",")
+		n, err := strconv.Atoi(start)
+		if err != nil {
+			return 0
+		}
+		return n
+	}
+	return 0
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// redactSecret keeps the first characters of secret to help locate it.
+func redactSecret(secret string) string {
+	const visible = 4
+	if len(secret) <= visible*2 {
+		return strings.Repeat("*", len(secret))
+	}
+	return secret[:visible] + strings.Repeat("*", 8)
+}
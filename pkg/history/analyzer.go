@@ -68,6 +68,9 @@ func (h *historyAnalyzer) Analyze(ctx context.Context, repo *repository.Reposito
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commit log: %w", err)
 	}
+	if err := checkMissingObjects(ctx, h.executor, repo, result); err != nil {
+		return nil, fmt.Errorf("failed to get commit log: %w", err)
+	}
 
 	// Parse output
 	stats, err := h.parseCommitStats(result.Stdout)
@@ -171,6 +171,9 @@ func (c *contributorAnalyzer) enrichContributor(ctx context.Context, repo *repos
 	if err != nil {
 		return fmt.Errorf("failed to get contributor stats: %w", err)
 	}
+	if err := checkMissingObjects(ctx, c.executor, repo, result); err != nil {
+		return fmt.Errorf("failed to get contributor stats: %w", err)
+	}
 
 	// Parse detailed stats
 	c.parseContributorStats(contributor, result.Stdout)
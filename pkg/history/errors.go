@@ -1,6 +1,14 @@
 package history
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+	"github.com/gizzahub/gzh-cli-git/pkg/repository"
+)
 
 var (
 	// ErrEmptyHistory indicates repository has no commit history
@@ -23,4 +31,33 @@ var (
 
 	// ErrInvalidBranch indicates invalid branch name
 	ErrInvalidBranch = errors.New("invalid branch name")
+
+	// ErrMissingObjects indicates objects omitted by a partial clone could not be
+	// fetched from the promisor remote (e.g. offline or without access)
+	ErrMissingObjects = errors.New("objects missing from partial clone could not be fetched")
 )
+
+// checkMissingObjects returns ErrMissingObjects if a git command failed because
+// the objects it needed were omitted by a partial clone and could not be fetched.
+// Git fetches such objects on demand, so this only happens when the promisor
+// remote is unreachable. The partial clone configuration is only read then.
+func checkMissingObjects(ctx context.Context, executor GitExecutor, repo *repository.Repository, result *gitcmd.Result) error {
+	if result.ExitCode == 0 || !strings.Contains(result.Stderr, "from promisor remote") {
+		return nil
+	}
+
+	var partial *repository.PartialClone
+	config, err := executor.Run(ctx, repo.Path, "config", "--get-regexp", repository.PartialCloneConfigPattern)
+	if err == nil && config.ExitCode == 0 {
+		partial = repository.ParsePartialCloneConfig(strings.Split(strings.TrimSpace(config.Stdout), "\n"))
+	}
+
+	remote := "the promisor remote"
+	if partial != nil {
+		remote = fmt.Sprintf("remote '%s'", partial.Remote)
+		if partial.Filter != "" {
+			return fmt.Errorf("%w (filter %s): %s is not reachable", ErrMissingObjects, partial.Filter, remote)
+		}
+	}
+	return fmt.Errorf("%w: %s is not reachable", ErrMissingObjects, remote)
+}
@@ -63,6 +63,9 @@ func (f *fileHistoryTracker) GetHistory(ctx context.Context, repo *repository.Re
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file history: %w", err)
 	}
+	if err := checkMissingObjects(ctx, f.executor, repo, result); err != nil {
+		return nil, fmt.Errorf("failed to get file history: %w", err)
+	}
 
 	// Parse output
 	commits, err := f.parseFileHistory(result.Stdout, path)
@@ -91,6 +94,9 @@ func (f *fileHistoryTracker) GetBlame(ctx context.Context, repo *repository.Repo
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file blame: %w", err)
 	}
+	if err := checkMissingObjects(ctx, f.executor, repo, result); err != nil {
+		return nil, fmt.Errorf("failed to get file blame: %w", err)
+	}
 
 	// Parse output
 	blameInfo, err := f.parseBlame(result.Stdout, path)
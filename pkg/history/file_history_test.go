@@ -2,7 +2,9 @@ package history
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -359,6 +361,36 @@ def456 (Jane Smith <jane@example.com> 2025-11-27 13:00:00 +0000 2) import "fmt"`
 	}
 }
 
+func TestFileHistoryTracker_MissingObjects(t *testing.T) {
+	executor := &mockExecutor{
+		runFunc: func(ctx context.Context, repoPath string, args ...string) (*gitcmd.Result, error) {
+			if args[0] == "config" {
+				return &gitcmd.Result{Stdout: "remote.origin.promisor true\nremote.origin.partialclonefilter blob:none\n"}, nil
+			}
+			return &gitcmd.Result{
+				Stdout:   "abc123|John Doe|john@example.com|1700000000|Initial commit\n",
+				Stderr:   "fatal: could not fetch 1191247b6d9a206f6ba3d8ac79e26d041dd86941 from promisor remote",
+				ExitCode: 128,
+			}, nil
+		},
+	}
+
+	tracker := NewFileHistoryTracker(executor)
+	repo := &repository.Repository{Path: "/test/repo"}
+
+	_, err := tracker.GetHistory(context.Background(), repo, "main.go", HistoryOptions{})
+	if !errors.Is(err, ErrMissingObjects) {
+		t.Fatalf("GetHistory() error = %v, want ErrMissingObjects", err)
+	}
+	if !strings.Contains(err.Error(), "blob:none") || !strings.Contains(err.Error(), "'origin'") {
+		t.Errorf("GetHistory() error = %v, want filter and remote", err)
+	}
+
+	if _, err := tracker.GetBlame(context.Background(), repo, "main.go"); !errors.Is(err, ErrMissingObjects) {
+		t.Errorf("GetBlame() error = %v, want ErrMissingObjects", err)
+	}
+}
+
 func TestFileHistoryTracker_ParseFileHistory(t *testing.T) {
 	tracker := &fileHistoryTracker{}
 
@@ -22,6 +22,10 @@ type BulkFetchOptions struct {
 	// Parallel is the number of concurrent workers (default: 5)
 	Parallel int
 
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
 	// MaxDepth is the maximum directory depth to scan (default: 5)
 	MaxDepth int
 
@@ -40,6 +44,9 @@ type BulkFetchOptions struct {
 	// Tags fetches all tags from remote
 	Tags bool
 
+	// Retry configures retries of transient network failures (default: no retries)
+	Retry RetryPolicy
+
 	// IncludeSubmodules includes git submodules in the scan (default: false)
 	// When false, only scans for independent nested repositories
 	IncludeSubmodules bool
@@ -50,11 +57,33 @@ type BulkFetchOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryFetchResult)
 }
 
 // BulkFetchResult contains the results of a bulk fetch operation
@@ -112,6 +141,10 @@ type RepositoryFetchResult struct {
 
 	// CommitsAhead is the number of commits ahead of remote after fetch
 	CommitsAhead int
+
+	// Attempts is the number of times the fetch was attempted; more than 1 means
+	// transient failures were retried (0 if it was not run)
+	Attempts int
 }
 
 // BulkPullOptions configures bulk repository pull operations
@@ -122,6 +155,10 @@ type BulkPullOptions struct {
 	// Parallel is the number of concurrent workers (default: 5)
 	Parallel int
 
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
 	// MaxDepth is the maximum directory depth to scan (default: 5)
 	MaxDepth int
 
@@ -140,9 +177,14 @@ type BulkPullOptions struct {
 	// Tags fetches all tags from remote
 	Tags bool
 
-	// Stash automatically stashes local changes before pull
+	// Stash automatically stashes local changes before pull and pops them afterwards.
+	// Entries are labelled with StashCreatorPull, so a stash left behind by a
+	// failed pop can be restored with BulkStash (CreatedBy: StashCreatorPull).
 	Stash bool
 
+	// Retry configures retries of transient network failures (default: no retries)
+	Retry RetryPolicy
+
 	// IncludeSubmodules includes git submodules in the scan (default: false)
 	// When false, only scans for independent nested repositories
 	IncludeSubmodules bool
@@ -153,11 +195,33 @@ type BulkPullOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryPullResult)
 }
 
 // BulkPullResult contains the results of a bulk pull operation
@@ -215,6 +279,13 @@ type RepositoryPullResult struct {
 
 	// Stashed indicates if local changes were stashed
 	Stashed bool
+
+	// Attempts is the number of times the pull was attempted; more than 1 means
+	// transient failures were retried (0 if it was not run)
+	Attempts int
+
+	// Undo is the state before the pull, for undoing it (nil in dry-run mode)
+	Undo *UndoState
 }
 
 // BulkPushOptions configures bulk repository push operations
@@ -225,6 +296,10 @@ type BulkPushOptions struct {
 	// Parallel is the number of concurrent workers (default: 5)
 	Parallel int
 
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
 	// MaxDepth is the maximum directory depth to scan (default: 5)
 	MaxDepth int
 
@@ -252,6 +327,9 @@ type BulkPushOptions struct {
 	// AllRemotes pushes to all configured remotes
 	AllRemotes bool
 
+	// Retry configures retries of transient network failures (default: no retries)
+	Retry RetryPolicy
+
 	// IncludeSubmodules includes git submodules in the scan (default: false)
 	// When false, only scans for independent nested repositories
 	IncludeSubmodules bool
@@ -262,11 +340,33 @@ type BulkPushOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryPushResult)
 }
 
 // BulkPushResult contains the results of a bulk push operation
@@ -318,6 +418,10 @@ type RepositoryPushResult struct {
 
 	// PushedCommits is the number of commits pushed
 	PushedCommits int
+
+	// Attempts is the highest number of times a push to one remote was attempted;
+	// more than 1 means transient failures were retried (0 if it was not run)
+	Attempts int
 }
 
 // BulkStatusOptions configures bulk repository status check operations
@@ -344,11 +448,33 @@ type BulkStatusOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryStatusResult)
 }
 
 // BulkStatusResult contains the results of a bulk status operation
@@ -425,6 +551,10 @@ type BulkUpdateOptions struct {
 	// Parallel is the number of concurrent workers (default: 5)
 	Parallel int
 
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
 	// MaxDepth is the maximum directory depth to scan (default: 5)
 	MaxDepth int
 
@@ -447,11 +577,33 @@ type BulkUpdateOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryUpdateResult)
 }
 
 // BulkUpdateResult contains the results of a bulk update operation
@@ -501,26 +653,1102 @@ type RepositoryUpdateResult struct {
 	// CommitsBehind is how many commits behind remote
 	CommitsBehind int
 
-	// CommitsAhead is how many commits ahead of remote
+	// CommitsAhead is how many commits ahead of remote
+	CommitsAhead int
+
+	// HasStash indicates if there are stashed changes
+	HasStash bool
+
+	// InMergeState indicates if repository is in merge state
+	InMergeState bool
+
+	// HasUncommittedChanges indicates if there are local changes
+	HasUncommittedChanges bool
+}
+
+// BulkSwitchOptions configures bulk repository branch switch operations
+type BulkSwitchOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Branch is the target branch to switch to (required)
+	Branch string
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun performs simulation without actual changes
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// Create creates the branch if it doesn't exist
+	Create bool
+
+	// Force forces the switch even with uncommitted changes (dangerous)
+	Force bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositorySwitchResult)
+}
+
+// BulkSwitchResult contains the results of a bulk switch operation
+type BulkSwitchResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositorySwitchResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// TargetBranch is the branch that was switched to
+	TargetBranch string
+}
+
+// RepositorySwitchResult represents the result for a single repository switch
+type RepositorySwitchResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (switched, already-on-branch, dirty, error, etc.)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// PreviousBranch is the branch before switching
+	PreviousBranch string
+
+	// CurrentBranch is the branch after switching (or current if not switched)
+	CurrentBranch string
+
+	// RemoteURL is the remote origin URL
+	RemoteURL string
+
+	// HasUncommittedChanges indicates if there were local changes preventing switch
+	HasUncommittedChanges bool
+
+	// Undo is the state before the switch, for undoing it (nil if not attempted)
+	Undo *UndoState
+}
+
+// BulkCloneOptions configures bulk repository clone operations
+type BulkCloneOptions struct {
+	// URLs is the list of repository URLs to clone
+	URLs []string
+
+	// URLFile is a file containing repository URLs, one per line.
+	// Blank lines and lines starting with '#' are ignored.
+	// URLs from the file are appended to URLs.
+	URLFile string
+
+	// Directory is the root directory to clone into (default: current directory)
+	Directory string
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
+	// Branch is the branch to check out in every clone (default: remote default branch)
+	Branch string
+
+	// Depth limits the clone depth (0 means full clone)
+	Depth int
+
+	// SingleBranch clones only the checked-out branch
+	SingleBranch bool
+
+	// Filter creates partial clones with this object filter (e.g. "blob:none", "tree:0")
+	Filter string
+
+	// SparsePaths enables a cone-mode sparse checkout of these directories in every clone
+	SparsePaths []string
+
+	// DryRun performs simulation without actual changes
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryCloneResult)
+}
+
+// BulkCloneResult contains the results of a bulk clone operation
+type BulkCloneResult struct {
+	// TotalRequested is the number of URLs requested
+	TotalRequested int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryCloneResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+}
+
+// RepositoryCloneResult represents the result for a single repository clone
+type RepositoryCloneResult struct {
+	// Path is the destination path
+	Path string
+
+	// RelativePath is the path relative to the clone root
+	RelativePath string
+
+	// URL is the repository URL
+	URL string
+
+	// Status is the operation status (cloned, skipped, would-clone, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+}
+
+// BulkExecOptions configures running a git command across repositories
+type BulkExecOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Args is the git command to run, without the leading "git" (required).
+	// The first argument must be a git subcommand; global options such as
+	// -c or -C are not accepted. Args are validated with gitcmd.SanitizeArgs.
+	Args []string
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun lists the repositories without running the command
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryExecResult)
+}
+
+// BulkExecResult contains the results of a bulk exec operation
+type BulkExecResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryExecResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Args is the git command that was run
+	Args []string
+}
+
+// RepositoryExecResult represents the result of running a git command in a single repository
+type RepositoryExecResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (success, error, would-run)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the command could not be run or exited with a non-zero code
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// ExitCode is the command's exit code (-1 if it could not be run)
+	ExitCode int
+
+	// Stdout is the command's standard output
+	Stdout string
+
+	// Stderr is the command's standard error output
+	Stderr string
+}
+
+// BulkStashOptions configures bulk stash operations
+type BulkStashOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Action is the stash operation to perform (required)
+	Action StashAction
+
+	// Message is an optional note for StashSave
+	Message string
+
+	// CreatedBy selects entries by the operation that created them
+	// (e.g. StashCreatorPull) for StashList, StashPop and StashDrop;
+	// empty selects all entries. For StashSave it labels the new entry
+	// (default: StashCreatorStash).
+	CreatedBy string
+
+	// IncludeUntracked also stashes untracked files (StashSave only)
+	IncludeUntracked bool
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun performs simulation without actual changes
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryStashResult)
+}
+
+// BulkStashResult contains the results of a bulk stash operation
+type BulkStashResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryStashResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Action is the stash operation that was performed
+	Action StashAction
+}
+
+// RepositoryStashResult represents the result of a stash operation on a single repository
+type RepositoryStashResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (stashed, popped, dropped, has-stash, no-stash, clean, conflict, error, etc.)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Branch is the current branch
+	Branch string
+
+	// Entry is the entry that was saved, popped or dropped
+	Entry *StashEntry
+
+	// Stashes are the matching entries remaining after the operation, newest first
+	Stashes []StashEntry
+}
+
+// BulkTagOptions configures coordinated tag creation across repositories
+type BulkTagOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Tag is the name of the tag to create (required)
+	Tag string
+
+	// Message is the annotation message (default: the tag name)
+	Message string
+
+	// Branch is the branch every repository must be on (empty = any branch)
+	Branch string
+
+	// Push pushes the created tags to Remote
+	Push bool
+
+	// Remote is the remote to push tags to (default: origin)
+	Remote string
+
+	// AllOrNothing creates tags only if every repository passes verification,
+	// and deletes already-created (and pushed) tags if creating or pushing fails anywhere
+	AllOrNothing bool
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun verifies repositories without creating tags
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each verified repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes verification.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as no later phase can
+	// change it. With AllOrNothing, verified repositories are reported once the run is
+	// complete, since a later failure can still skip or roll them back.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryTagResult)
+}
+
+// BulkTagResult contains the results of a bulk tag operation
+type BulkTagResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryTagResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Tag is the tag that was created
+	Tag string
+
+	// RolledBack indicates created tags were deleted because of a failure (AllOrNothing)
+	RolledBack bool
+}
+
+// RepositoryTagResult represents the tag result for a single repository
+type RepositoryTagResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (tagged, pushed, would-tag, dirty, wrong-branch, tag-exists, rolled-back, skipped, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Branch is the current branch
+	Branch string
+
+	// Commit is the commit the tag points to
+	Commit string
+
+	// Created indicates the tag was created locally
+	Created bool
+
+	// Pushed indicates the tag was pushed to the remote
+	Pushed bool
+}
+
+// BulkRemoteSetURLOptions configures rewriting a remote URL across repositories
+type BulkRemoteSetURLOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Remote is the remote whose URL is rewritten (default: origin)
+	Remote string
+
+	// From is a regular expression matched against the current URL (required).
+	// Repositories whose URL does not match are left unchanged.
+	From string
+
+	// To replaces the matched part of the URL (required). It may refer to
+	// submatches of From as $1 or ${1}, and to named submatches as ${name}.
+	To string
+
+	// Verify checks that the new URL is reachable with ls-remote before
+	// setting it; unreachable repositories keep their current URL
+	Verify bool
+
+	// HostLimits caps concurrent reachability checks per host of the new URL
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun reports the rewritten URLs without setting them
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryRemoteSetURLResult)
+}
+
+// BulkRemoteSetURLResult contains the results of a bulk remote URL rewrite
+type BulkRemoteSetURLResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryRemoteSetURLResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Remote is the remote whose URL was rewritten
+	Remote string
+}
+
+// RepositoryRemoteSetURLResult represents the remote URL rewrite result for a single repository
+type RepositoryRemoteSetURLResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (updated, would-update, up-to-date, no-match, no-remote, unreachable, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// OldURL is the URL before the rewrite
+	OldURL string
+
+	// NewURL is the rewritten URL (empty if the URL did not match)
+	NewURL string
+
+	// Verified indicates the new URL was reachable with ls-remote
+	Verified bool
+}
+
+// BulkMaintainOptions configures repository maintenance across repositories
+type BulkMaintainOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Tasks are the maintenance tasks to run, in order (default: DefaultMaintenanceTasks)
+	Tasks []MaintenanceTask
+
+	// Aggressive makes gc optimize packs more thoroughly, at the cost of much more time
+	Aggressive bool
+
+	// PruneExpire is the minimum age of unreachable objects removed by the prune task,
+	// in any format git understands (default: "2.weeks.ago", as git gc)
+	PruneExpire string
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// DryRun measures repositories without running any task
+	DryRun bool
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryMaintainResult)
+}
+
+// BulkMaintainResult contains the results of a bulk maintenance operation
+type BulkMaintainResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryMaintainResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Tasks are the maintenance tasks that were run
+	Tasks []MaintenanceTask
+
+	// SizeBefore is the combined size of all Git directories before maintenance, in bytes
+	SizeBefore int64
+
+	// SizeAfter is the combined size of all Git directories after maintenance, in bytes
+	SizeAfter int64
+}
+
+// RepositoryMaintainResult represents the maintenance result for a single repository
+type RepositoryMaintainResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (maintained, would-maintain, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Before are the Git directory statistics before maintenance
+	Before GitDirStats
+
+	// After are the Git directory statistics after maintenance
+	// (equal to Before in dry-run mode or if no task ran)
+	After GitDirStats
+
+	// Tasks are the tasks that completed successfully
+	Tasks []MaintenanceTask
+}
+
+// BulkDoctorOptions configures repository health checks across repositories
+type BulkDoctorOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// Remote is the remote the checks compare against (default: origin)
+	Remote string
+
+	// StashMaxAge is the age from which stash entries are reported as old (default: 90 days)
+	StashMaxAge time.Duration
+
+	// Online also runs checks that contact the remote (stale remote-tracking branches)
+	Online bool
+
+	// Fix applies safe remedies: setting a missing upstream, updating the remote
+	// default branch and pruning stale remote-tracking branches
+	Fix bool
+
+	// HostLimits caps concurrent remote operations per host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryDoctorResult)
+}
+
+// BulkDoctorResult contains the results of bulk repository health checks
+type BulkDoctorResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryDoctorResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// Issues counts the repositories with an unfixed issue, by check
+	Issues map[HealthCheck]int
+
+	// Fixed is the number of issues fixed
+	Fixed int
+}
+
+// RepositoryDoctorResult represents the health check result for a single repository
+type RepositoryDoctorResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (healthy, fixed, unhealthy, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the checks could not be run
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Branch is the current branch (empty if HEAD is detached)
+	Branch string
+
+	// Issues are the problems found, including fixed ones
+	Issues []HealthIssue
+}
+
+// BulkIncomingOptions configures listing incoming commits across repositories
+type BulkIncomingOptions struct {
+	// Directory is the root directory to scan for repositories
+	Directory string
+
+	// MaxCommits is the maximum number of commits listed per repository
+	// (default: 20); CommitsBehind always has the full count
+	MaxCommits int
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// MaxDepth is the maximum directory depth to scan (default: 1)
+	MaxDepth int
+
+	// Verbose enables detailed logging
+	Verbose bool
+
+	// IncludeSubmodules includes git submodules in the scan (default: false)
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryIncomingResult)
+}
+
+// BulkIncomingResult contains the incoming commits of all repositories
+type BulkIncomingResult struct {
+	// TotalScanned is the number of repositories found
+	TotalScanned int
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryIncomingResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+
+	// TotalCommits is the number of incoming commits across all repositories
+	TotalCommits int
+}
+
+// RepositoryIncomingResult represents the incoming commits of a single repository
+type RepositoryIncomingResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to scan root
+	RelativePath string
+
+	// Status is the operation status (incoming, up-to-date, no-upstream, skipped, error)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Branch is the current branch
+	Branch string
+
+	// Upstream is the upstream branch, e.g. origin/main
+	Upstream string
+
+	// CommitsBehind is the number of upstream commits not in the local branch
+	CommitsBehind int
+
+	// CommitsAhead is the number of local commits not on the upstream
 	CommitsAhead int
 
-	// HasStash indicates if there are stashed changes
-	HasStash bool
-
-	// InMergeState indicates if repository is in merge state
-	InMergeState bool
-
-	// HasUncommittedChanges indicates if there are local changes
-	HasUncommittedChanges bool
+	// Commits are the incoming commits, newest first, at most MaxCommits
+	Commits []IncomingCommit
 }
 
-// BulkSwitchOptions configures bulk repository branch switch operations
-type BulkSwitchOptions struct {
+// BulkBackupOptions configures backing up repositories as bare mirrors
+type BulkBackupOptions struct {
 	// Directory is the root directory to scan for repositories
 	Directory string
 
-	// Branch is the target branch to switch to (required)
-	Branch string
+	// Destination is the directory holding the mirrors (required). Each mirror
+	// is stored under a path derived from its remote URL, e.g.
+	// github.com/org/repo.git, so clones of the same remote share one mirror.
+	Destination string
+
+	// BundleDir, if set, receives a git bundle of every mirror (e.g.
+	// github.com/org/repo.bundle) for cold storage. Bundles are rewritten
+	// only when their mirror changed.
+	BundleDir string
+
+	// HostLimits caps concurrent mirror clones and fetches per remote host
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
 
 	// Parallel is the number of concurrent workers (default: 5)
 	Parallel int
@@ -528,18 +1756,12 @@ type BulkSwitchOptions struct {
 	// MaxDepth is the maximum directory depth to scan (default: 1)
 	MaxDepth int
 
-	// DryRun performs simulation without actual changes
+	// DryRun reports which mirrors would be created or updated without touching them
 	DryRun bool
 
 	// Verbose enables detailed logging
 	Verbose bool
 
-	// Create creates the branch if it doesn't exist
-	Create bool
-
-	// Force forces the switch even with uncommitted changes (dangerous)
-	Force bool
-
 	// IncludeSubmodules includes git submodules in the scan (default: false)
 	IncludeSubmodules bool
 
@@ -549,15 +1771,37 @@ type BulkSwitchOptions struct {
 	// ExcludePattern is a regex pattern for repositories to exclude
 	ExcludePattern string
 
+	// Groups selects only repositories in at least one of these workspace groups
+	Groups []string
+
+	// ExcludeGroups skips repositories in any of these workspace groups
+	ExcludeGroups []string
+
+	// Workspace defines the groups; when nil and groups are selected,
+	// the workspace manifest is searched from Directory upwards
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts the operation to these repository paths
+	// (e.g. the failed repositories of a previous run); empty means all
+	OnlyRepositories []string
+
 	// Logger for operation feedback
 	Logger Logger
 
 	// ProgressCallback is called for each processed repository
 	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryBackupResult)
 }
 
-// BulkSwitchResult contains the results of a bulk switch operation
-type BulkSwitchResult struct {
+// BulkBackupResult contains the results of a bulk backup operation
+type BulkBackupResult struct {
 	// TotalScanned is the number of repositories found
 	TotalScanned int
 
@@ -565,7 +1809,7 @@ type BulkSwitchResult struct {
 	TotalProcessed int
 
 	// Repositories contains individual repository results
-	Repositories []RepositorySwitchResult
+	Repositories []RepositoryBackupResult
 
 	// Duration is the total operation time
 	Duration time.Duration
@@ -573,19 +1817,20 @@ type BulkSwitchResult struct {
 	// Summary contains status counts
 	Summary map[string]int
 
-	// TargetBranch is the branch that was switched to
-	TargetBranch string
+	// Destination is the directory holding the mirrors
+	Destination string
 }
 
-// RepositorySwitchResult represents the result for a single repository switch
-type RepositorySwitchResult struct {
+// RepositoryBackupResult represents the backup of a single repository
+type RepositoryBackupResult struct {
 	// Path is the repository path
 	Path string
 
 	// RelativePath is the path relative to scan root
 	RelativePath string
 
-	// Status is the operation status (switched, already-on-branch, dirty, error, etc.)
+	// Status is the operation status (cloned, updated, up-to-date, would-clone,
+	// would-update, no-remote, skipped, error)
 	Status string
 
 	// Message is a human-readable status message
@@ -597,17 +1842,59 @@ type RepositorySwitchResult struct {
 	// Duration is how long this repository took to process
 	Duration time.Duration
 
-	// PreviousBranch is the branch before switching
-	PreviousBranch string
+	// RemoteURL is the URL of the origin remote, without credentials
+	RemoteURL string
 
-	// CurrentBranch is the branch after switching (or current if not switched)
-	CurrentBranch string
+	// MirrorPath is the path of the bare mirror
+	MirrorPath string
 
-	// RemoteURL is the remote origin URL
-	RemoteURL string
+	// BundlePath is the path of the bundle, if one was written in this run
+	BundlePath string
+}
 
-	// HasUncommittedChanges indicates if there were local changes preventing switch
-	HasUncommittedChanges bool
+// DiscoverOptions configures repository discovery
+type DiscoverOptions struct {
+	// Directory is the root directory to scan
+	Directory string
+
+	// MaxDepth is the maximum directory depth to scan
+	MaxDepth int
+
+	// IncludeSubmodules includes nested repositories and submodules
+	IncludeSubmodules bool
+
+	// IncludePattern is a regex pattern for repositories to include
+	IncludePattern string
+
+	// ExcludePattern is a regex pattern for repositories to exclude
+	ExcludePattern string
+
+	// Groups selects repositories by workspace group
+	Groups []string
+
+	// ExcludeGroups excludes repositories by workspace group
+	ExcludeGroups []string
+
+	// Workspace is the manifest that defines the groups (optional)
+	Workspace *WorkspaceManifest
+
+	// OnlyRepositories restricts discovery to these repository paths; empty means all
+	OnlyRepositories []string
+
+	// Logger for operation feedback
+	Logger Logger
+}
+
+// DiscoverResult contains the repositories selected by discovery
+type DiscoverResult struct {
+	// Directory is the absolute path that was scanned
+	Directory string
+
+	// Repositories are the absolute paths of the selected repositories
+	Repositories []string
+
+	// TotalScanned is the number of repositories found before filtering
+	TotalScanned int
 }
 
 // BulkUpdate scans for repositories and updates them in parallel
@@ -627,6 +1914,8 @@ func (c *client) BulkUpdate(ctx context.Context, opts BulkUpdateOptions) (*BulkU
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -684,11 +1973,19 @@ func (c *client) scanRepositoriesWithConfig(ctx context.Context, dir string, max
 	// Start depth at 0 (root directory is depth 0)
 	// maxDepth=1 means scan only direct children of root directory (depth 0 -> depth 1)
 	// maxDepth=2 means scan root's children + their children (depth 0 -> depth 1 -> depth 2)
+	if c.scanIndex != nil && config.index == nil {
+		config.index = c.openScanIndex(dir, maxDepth, config.includeSubmodules, logger)
+	}
+
 	err := c.walkDirectoryWithConfig(ctx, dir, 0, maxDepth, &repos, &mu, logger, config)
 	if err != nil {
 		return nil, err
 	}
 
+	if config.index != nil {
+		config.index.save(logger)
+	}
+
 	// Sort for consistent ordering
 	sort.Strings(repos)
 
@@ -731,6 +2028,9 @@ func isSubmodule(dir string) bool {
 // walkDirectoryConfig holds configuration for walkDirectory
 type walkDirectoryConfig struct {
 	includeSubmodules bool
+
+	// index is the persistent discovery index, nil when disabled
+	index *scanIndex
 }
 
 // walkDirectory recursively walks directories to find Git repositories
@@ -763,8 +2063,11 @@ func (c *client) walkDirectoryWithConfig(ctx context.Context, dir string, depth,
 	default:
 	}
 
+	// Inspect the directory (from the index when it is unchanged)
+	info := c.inspectDirectory(ctx, dir, depth < maxDepth, logger, config.index)
+
 	// Check if this directory is a Git repository
-	if c.IsRepository(ctx, dir) {
+	if info.IsRepo {
 		mu.Lock()
 		*repos = append(*repos, dir)
 		mu.Unlock()
@@ -775,7 +2078,7 @@ func (c *client) walkDirectoryWithConfig(ctx context.Context, dir string, depth,
 		// 1. This is a submodule AND IncludeSubmodules is false
 		// 2. This is depth > 0 AND is an independent nested repo (to avoid recursing into nested repo's children)
 
-		if isSubmodule(dir) {
+		if info.IsSubmodule {
 			if !config.includeSubmodules {
 				// Skip submodule and its children
 				logger.Debug("skipping submodule", "path", dir)
@@ -802,34 +2105,65 @@ func (c *client) walkDirectoryWithConfig(ctx context.Context, dir string, depth,
 		return nil
 	}
 
-	// Read directory entries
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		// Log but don't fail on permission errors
-		logger.Debug("cannot read directory", "path", dir, "error", err)
-		return nil
+	// Scan subdirectories
+	for _, name := range info.Children {
+		subDir := filepath.Join(dir, name)
+		if err := c.walkDirectoryWithConfig(ctx, subDir, depth+1, maxDepth, repos, mu, logger, config); err != nil {
+			return err
+		}
 	}
 
-	// Scan subdirectories
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
+	return nil
+}
+
+// inspectDirectory determines whether dir is a repository or submodule and,
+// if readChildren is set, lists its subdirectories that are not ignored.
+// With an index, unchanged directories are answered from the cache and
+// freshly inspected directories are recorded for the next scan.
+func (c *client) inspectDirectory(ctx context.Context, dir string, readChildren bool, logger Logger, index *scanIndex) scanIndexEntry {
+	var modTime time.Time
+	if index != nil {
+		if stat, err := os.Stat(dir); err == nil {
+			modTime = stat.ModTime()
+			if entry, ok := index.lookup(dir, modTime, readChildren); ok {
+				index.store(dir, entry)
+				return entry
+			}
 		}
+	}
 
-		name := entry.Name()
+	entry := scanIndexEntry{
+		ModTime: modTime.UnixNano(),
+		IsRepo:  c.IsRepository(ctx, dir),
+	}
+	if entry.IsRepo {
+		entry.IsSubmodule = isSubmodule(dir)
+	}
 
-		// Skip hidden directories and common ignore patterns
-		if shouldIgnoreDirectory(name) {
-			continue
+	if readChildren {
+		// Read directory entries
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// Log but don't fail on permission errors; don't cache the failure
+			logger.Debug("cannot read directory", "path", dir, "error", err)
+			return entry
 		}
 
-		subDir := filepath.Join(dir, name)
-		if err := c.walkDirectoryWithConfig(ctx, subDir, depth+1, maxDepth, repos, mu, logger, config); err != nil {
-			return err
+		entry.HasChildren = true
+		for _, e := range entries {
+			// Skip files, hidden directories and common ignore patterns
+			if !e.IsDir() || shouldIgnoreDirectory(e.Name()) {
+				continue
+			}
+			entry.Children = append(entry.Children, e.Name())
 		}
 	}
 
-	return nil
+	if index != nil && !modTime.IsZero() {
+		index.store(dir, entry)
+	}
+
+	return entry
 }
 
 // shouldIgnoreDirectory checks if a directory should be skipped
@@ -861,8 +2195,8 @@ func shouldIgnoreDirectory(name string) bool {
 }
 
 // filterRepositories filters repositories based on include/exclude patterns
-func filterRepositories(repos []string, includePattern, excludePattern string, logger Logger) ([]string, error) {
-	if includePattern == "" && excludePattern == "" {
+func filterRepositories(repos []string, includePattern, excludePattern string, groups *groupFilter, logger Logger) ([]string, error) {
+	if includePattern == "" && excludePattern == "" && groups == nil {
 		return repos, nil
 	}
 
@@ -897,6 +2231,12 @@ func filterRepositories(repos []string, includePattern, excludePattern string, l
 			continue
 		}
 
+		// Check workspace groups
+		if groups != nil && !groups.match(repo) {
+			logger.Debug("not selected by group", "path", repo)
+			continue
+		}
+
 		filtered = append(filtered, repo)
 	}
 
@@ -907,33 +2247,29 @@ func filterRepositories(repos []string, includePattern, excludePattern string, l
 func (c *client) processRepositories(ctx context.Context, rootDir string, repos []string, opts BulkUpdateOptions, logger Logger) ([]RepositoryUpdateResult, error) {
 	results := make([]RepositoryUpdateResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := c.remoteURLs(ctx, hosts, repos, "origin", opts.Parallel)
 
-	// Create error group with concurrency limit
-	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(opts.Parallel)
-
-	for i, repoPath := range repos {
-		i, repoPath := i, repoPath // capture loop variables
-
-		g.Go(func() error {
-			// Call progress callback
-			if opts.ProgressCallback != nil {
-				opts.ProgressCallback(i+1, len(repos), repoPath)
-			}
-
-			result := c.processRepository(gctx, rootDir, repoPath, opts, logger)
-
-			mu.Lock()
-			results[i] = result
-			mu.Unlock()
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
 
-			return nil // Don't fail entire operation on single repo error
-		})
-	}
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+		result := c.processRepository(ctx, rootDir, repoPath, opts, logger)
 
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
 
 	return results, nil
 }
@@ -944,7 +2280,7 @@ func (c *client) processRepository(ctx context.Context, rootDir, repoPath string
 
 	result := RepositoryUpdateResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -1038,8 +2374,9 @@ func (c *client) processRepository(ctx context.Context, rootDir, repoPath string
 	return result
 }
 
-// getRelativePath returns the relative path from root to target
-func getRelativePath(root, target string) string {
+// RelativePath returns the path of target relative to root, or target if it
+// cannot be made relative.
+func RelativePath(root, target string) string {
 	rel, err := filepath.Rel(root, target)
 	if err != nil {
 		return target
@@ -1075,6 +2412,8 @@ func (c *client) BulkFetch(ctx context.Context, opts BulkFetchOptions) (*BulkFet
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -1121,33 +2460,29 @@ func (c *client) BulkFetch(ctx context.Context, opts BulkFetchOptions) (*BulkFet
 func (c *client) processFetchRepositories(ctx context.Context, rootDir string, repos []string, opts BulkFetchOptions, logger Logger) ([]RepositoryFetchResult, error) {
 	results := make([]RepositoryFetchResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := c.remoteURLs(ctx, hosts, repos, "origin", opts.Parallel)
 
-	// Create error group with concurrency limit
-	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(opts.Parallel)
-
-	for i, repoPath := range repos {
-		i, repoPath := i, repoPath // capture loop variables
-
-		g.Go(func() error {
-			// Call progress callback
-			if opts.ProgressCallback != nil {
-				opts.ProgressCallback(i+1, len(repos), repoPath)
-			}
-
-			result := c.processFetchRepository(gctx, rootDir, repoPath, opts, logger)
-
-			mu.Lock()
-			results[i] = result
-			mu.Unlock()
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
 
-			return nil // Don't fail entire operation on single repo error
-		})
-	}
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+		result := c.processFetchRepository(ctx, rootDir, repoPath, opts, logger)
 
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
 
 	return results, nil
 }
@@ -1158,7 +2493,7 @@ func (c *client) processFetchRepository(ctx context.Context, rootDir, repoPath s
 
 	result := RepositoryFetchResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -1222,16 +2557,13 @@ func (c *client) processFetchRepository(ctx context.Context, rootDir, repoPath s
 		fetchArgs = append(fetchArgs, "--quiet")
 	}
 
-	// Perform fetch
-	fetchResult, err := c.executor.Run(ctx, repoPath, fetchArgs...)
-	if err != nil || fetchResult.ExitCode != 0 {
+	// Perform fetch, retrying transient network failures
+	_, attempts, err := c.runWithRetry(ctx, repoPath, opts.Retry, logger, fetchArgs...)
+	result.Attempts = attempts
+	if err != nil {
 		result.Status = StatusError
 		result.Message = "Fetch failed"
-		if err != nil {
-			result.Error = err
-		} else {
-			result.Error = fmt.Errorf("fetch exited with code %d: %s", fetchResult.ExitCode, fetchResult.Error)
-		}
+		result.Error = gitFailure("fetch", err)
 		result.Duration = time.Since(startTime)
 		return result
 	}
@@ -1314,6 +2646,8 @@ func (c *client) BulkPull(ctx context.Context, opts BulkPullOptions) (*BulkPullR
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -1360,33 +2694,29 @@ func (c *client) BulkPull(ctx context.Context, opts BulkPullOptions) (*BulkPullR
 func (c *client) processPullRepositories(ctx context.Context, rootDir string, repos []string, opts BulkPullOptions, logger Logger) ([]RepositoryPullResult, error) {
 	results := make([]RepositoryPullResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := c.remoteURLs(ctx, hosts, repos, "origin", opts.Parallel)
 
-	// Create error group with concurrency limit
-	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(opts.Parallel)
-
-	for i, repoPath := range repos {
-		i, repoPath := i, repoPath // capture loop variables
-
-		g.Go(func() error {
-			// Call progress callback
-			if opts.ProgressCallback != nil {
-				opts.ProgressCallback(i+1, len(repos), repoPath)
-			}
-
-			result := c.processPullRepository(gctx, rootDir, repoPath, opts, logger)
-
-			mu.Lock()
-			results[i] = result
-			mu.Unlock()
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
 
-			return nil // Don't fail entire operation on single repo error
-		})
-	}
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+		result := c.processPullRepository(ctx, rootDir, repoPath, opts, logger)
 
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
 
 	return results, nil
 }
@@ -1397,7 +2727,7 @@ func (c *client) processPullRepository(ctx context.Context, rootDir, repoPath st
 
 	result := RepositoryPullResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -1494,9 +2824,17 @@ func (c *client) processPullRepository(ctx context.Context, rootDir, repoPath st
 		return result
 	}
 
-	// Handle local changes with stash
-	if !status.IsClean && opts.Stash {
-		stashArgs := []string{"stash", "push", "-m", "Auto-stash by gz-git pull"}
+	// Record the state before the pull so it can be undone; the deferred update
+	// fills in the state after the pull on every return path below
+	if !opts.DryRun {
+		result.Undo = c.captureUndoState(ctx, repoPath)
+		defer c.completeUndoState(ctx, repoPath, result.Undo)
+	}
+
+	// Handle local changes with stash (labelled so `multi stash` can find it
+	// if the pop below fails); a dry run never touches the working tree
+	if !status.IsClean && opts.Stash && !opts.DryRun {
+		stashArgs := []string{"stash", "push", "-m", StashMessage(StashCreatorPull, "auto-stash before pull")}
 		stashResult, err := c.executor.Run(ctx, repoPath, stashArgs...)
 		if err != nil || stashResult.ExitCode != 0 {
 			result.Status = StatusError
@@ -1510,6 +2848,9 @@ func (c *client) processPullRepository(ctx context.Context, rootDir, repoPath st
 			return result
 		}
 		result.Stashed = true
+		if result.Undo != nil {
+			result.Undo.Stash = c.latestStash(ctx, repoPath)
+		}
 		logger.Info("stashed local changes", "path", result.RelativePath)
 	}
 
@@ -1556,9 +2897,10 @@ func (c *client) processPullRepository(ctx context.Context, rootDir, repoPath st
 		pullArgs = append(pullArgs, "--quiet")
 	}
 
-	// Perform pull
-	pullResult, err := c.executor.Run(ctx, repoPath, pullArgs...)
-	if err != nil || pullResult.ExitCode != 0 {
+	// Perform pull, retrying transient network failures
+	pullResult, attempts, err := c.runWithRetry(ctx, repoPath, opts.Retry, logger, pullArgs...)
+	result.Attempts = attempts
+	if err != nil {
 		// Check if pull failed due to conflicts
 		postPullState, stateErr := c.checkRepositoryState(ctx, repoPath)
 		if stateErr == nil {
@@ -1581,21 +2923,13 @@ func (c *client) processPullRepository(ctx context.Context, rootDir, repoPath st
 				// Non-conflict error
 				result.Status = StatusError
 				result.Message = "Pull failed"
-				if err != nil {
-					result.Error = err
-				} else {
-					result.Error = fmt.Errorf("pull exited with code %d: %s", pullResult.ExitCode, pullResult.Error)
-				}
+				result.Error = gitFailure("pull", err)
 			}
 		} else {
 			// Couldn't check state, report general error
 			result.Status = StatusError
 			result.Message = "Pull failed"
-			if err != nil {
-				result.Error = err
-			} else {
-				result.Error = fmt.Errorf("pull exited with code %d: %s", pullResult.ExitCode, pullResult.Error)
-			}
+			result.Error = gitFailure("pull", err)
 		}
 		result.Duration = time.Since(startTime)
 
@@ -1729,6 +3063,8 @@ func (c *client) BulkPush(ctx context.Context, opts BulkPushOptions) (*BulkPushR
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -1775,33 +3111,29 @@ func (c *client) BulkPush(ctx context.Context, opts BulkPushOptions) (*BulkPushR
 func (c *client) processPushRepositories(ctx context.Context, rootDir string, repos []string, opts BulkPushOptions, logger Logger) ([]RepositoryPushResult, error) {
 	results := make([]RepositoryPushResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := c.remoteURLs(ctx, hosts, repos, "origin", opts.Parallel)
 
-	// Create error group with concurrency limit
-	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(opts.Parallel)
-
-	for i, repoPath := range repos {
-		i, repoPath := i, repoPath // capture loop variables
-
-		g.Go(func() error {
-			// Call progress callback
-			if opts.ProgressCallback != nil {
-				opts.ProgressCallback(i+1, len(repos), repoPath)
-			}
-
-			result := c.processPushRepository(gctx, rootDir, repoPath, opts, logger)
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
 
-			mu.Lock()
-			results[i] = result
-			mu.Unlock()
-
-			return nil // Don't fail entire operation on single repo error
-		})
-	}
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+		result := c.processPushRepository(ctx, rootDir, repoPath, opts, logger)
 
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
 
 	return results, nil
 }
@@ -1812,7 +3144,7 @@ func (c *client) processPushRepository(ctx context.Context, rootDir, repoPath st
 
 	result := RepositoryPushResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -1951,7 +3283,11 @@ func (c *client) processPushRepository(ctx context.Context, rootDir, repoPath st
 	// Push to each remote
 	var pushErrors []string
 	for _, remote := range remotes {
-		if err := c.pushToRemote(ctx, repoPath, remote, info.Branch, opts); err != nil {
+		attempts, err := c.pushToRemote(ctx, repoPath, remote, info.Branch, opts, logger)
+		if attempts > result.Attempts {
+			result.Attempts = attempts
+		}
+		if err != nil {
 			pushErrors = append(pushErrors, fmt.Sprintf("%s: %v", remote, err))
 		}
 	}
@@ -1980,8 +3316,9 @@ func (c *client) processPushRepository(ctx context.Context, rootDir, repoPath st
 	return result
 }
 
-// pushToRemote performs the actual push to a single remote
-func (c *client) pushToRemote(ctx context.Context, repoPath, remote, branch string, opts BulkPushOptions) error {
+// pushToRemote performs the actual push to a single remote, retrying transient
+// network failures, and returns the number of attempts made
+func (c *client) pushToRemote(ctx context.Context, repoPath, remote, branch string, opts BulkPushOptions, logger Logger) (int, error) {
 	// Build push command
 	pushArgs := []string{"push"}
 
@@ -2013,15 +3350,12 @@ func (c *client) pushToRemote(ctx context.Context, repoPath, remote, branch stri
 	}
 
 	// Perform push
-	pushResult, err := c.executor.Run(ctx, repoPath, pushArgs...)
-	if err != nil || pushResult.ExitCode != 0 {
-		if err != nil {
-			return err
-		}
-		return fmt.Errorf("push exited with code %d: %s", pushResult.ExitCode, pushResult.Error)
+	_, attempts, err := c.runWithRetry(ctx, repoPath, opts.Retry, logger, pushArgs...)
+	if err != nil {
+		return attempts, gitFailure("push", err)
 	}
 
-	return nil
+	return attempts, nil
 }
 
 // calculatePushSummary creates a summary of push results by status
@@ -2052,6 +3386,8 @@ func (c *client) BulkStatus(ctx context.Context, opts BulkStatusOptions) (*BulkS
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -2098,6 +3434,7 @@ func (c *client) BulkStatus(ctx context.Context, opts BulkStatusOptions) (*BulkS
 func (c *client) processStatusRepositories(ctx context.Context, rootDir string, repos []string, opts BulkStatusOptions, logger Logger) ([]RepositoryStatusResult, error) {
 	results := make([]RepositoryStatusResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
 
 	// Create error group with concurrency limit
 	g, gctx := errgroup.WithContext(ctx)
@@ -2111,11 +3448,16 @@ func (c *client) processStatusRepositories(ctx context.Context, rootDir string,
 			if opts.ProgressCallback != nil {
 				opts.ProgressCallback(i+1, len(repos), repoPath)
 			}
+			events.Started(i+1, repoPath)
 
 			result := c.processStatusRepository(gctx, rootDir, repoPath, opts, logger)
 
 			mu.Lock()
 			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
 			mu.Unlock()
 
 			return nil // Don't fail entire operation on single repo error
@@ -2135,7 +3477,7 @@ func (c *client) processStatusRepository(ctx context.Context, rootDir, repoPath
 
 	result := RepositoryStatusResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -2263,9 +3605,25 @@ type bulkOperationCommon struct {
 	IncludeSubmodules bool
 	IncludePattern    string
 	ExcludePattern    string
+	Groups            []string
+	ExcludeGroups     []string
+	Workspace         *WorkspaceManifest
+	OnlyRepositories  []string
 	Logger            Logger
 }
 
+// withGroups sets the workspace group selection
+func (c *bulkOperationCommon) withGroups(groups, excludeGroups []string, workspace *WorkspaceManifest) {
+	c.Groups = groups
+	c.ExcludeGroups = excludeGroups
+	c.Workspace = workspace
+}
+
+// withRepositories restricts the operation to the given repository paths
+func (c *bulkOperationCommon) withRepositories(paths []string) {
+	c.OnlyRepositories = paths
+}
+
 // initializeBulkOperation initializes common bulk operation settings
 // Returns initialized common config and absolute directory path
 func initializeBulkOperation(
@@ -2336,15 +3694,46 @@ func (c *client) scanAndFilterRepositories(
 	common.Logger.Info("scan complete", "found", len(repos))
 	totalScanned := len(repos)
 
+	// Resolve group selection
+	groups, err := newGroupFilter(common.Groups, common.ExcludeGroups, common.Workspace, common.Directory)
+	if err != nil {
+		return nil, totalScanned, err
+	}
+
 	// Filter repositories
-	filteredRepos, err := filterRepositories(repos, common.IncludePattern, common.ExcludePattern, common.Logger)
+	filteredRepos, err := filterRepositories(repos, common.IncludePattern, common.ExcludePattern, groups, common.Logger)
 	if err != nil {
 		return nil, totalScanned, fmt.Errorf("failed to filter repositories: %w", err)
 	}
 
+	// Restrict to explicitly selected repositories
+	if len(common.OnlyRepositories) > 0 {
+		filteredRepos = selectRepositories(filteredRepos, common.OnlyRepositories)
+	}
+
 	if len(filteredRepos) < len(repos) {
 		common.Logger.Info("filtered repositories", "total", len(repos), "selected", len(filteredRepos))
 	}
 
 	return filteredRepos, totalScanned, nil
 }
+
+// selectRepositories keeps the repositories whose path is in only, preserving scan order.
+// Repositories in only that were not found by the scan are ignored.
+func selectRepositories(repos, only []string) []string {
+	wanted := make(map[string]bool, len(only))
+	for _, path := range only {
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		wanted[filepath.Clean(path)] = true
+	}
+
+	selected := make([]string, 0, len(only))
+	for _, repo := range repos {
+		if wanted[filepath.Clean(repo)] {
+			selected = append(selected, repo)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,415 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// BulkBackup scans for repositories and keeps a bare mirror of the origin
+// remote of each under opts.Destination.
+//
+// Mirrors are keyed by remote URL (host and path, so SSH and HTTPS clones of
+// the same repository share one mirror). The first run creates them with
+// git clone --mirror; later runs fetch with --prune, so only new objects are
+// transferred. With BundleDir, a bundle of every new or changed mirror is
+// written for cold storage.
+func (c *client) BulkBackup(ctx context.Context, opts BulkBackupOptions) (*BulkBackupResult, error) {
+	startTime := time.Now()
+
+	// Validate required options
+	if opts.Destination == "" {
+		return nil, &ValidationError{Field: "destination", Value: opts.Destination, Reason: "backup destination is required"}
+	}
+	destination, err := filepath.Abs(opts.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve destination: %w", err)
+	}
+	opts.Destination = destination
+	if opts.BundleDir != "" {
+		if opts.BundleDir, err = filepath.Abs(opts.BundleDir); err != nil {
+			return nil, fmt.Errorf("failed to resolve bundle directory: %w", err)
+		}
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkBackupResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryBackupResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Destination:    opts.Destination,
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processBackupRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkBackupResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateBackupSummary(results),
+		Destination:    opts.Destination,
+	}, nil
+}
+
+// backupTarget is the mirror planned for a single repository.
+type backupTarget struct {
+	RemoteURL string
+	Key       string
+	Owner     string // relative path of the repository that backs up a shared mirror
+	Err       error
+}
+
+// planBackupTargets resolves the origin remote and mirror key of every
+// repository. When several repositories share a remote, the first in scan
+// order owns the mirror and the others are reported as skipped.
+func (c *client) planBackupTargets(ctx context.Context, rootDir string, repos []string, parallel int) []backupTarget {
+	targets := make([]backupTarget, len(repos))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallel)
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+		g.Go(func() error {
+			targets[i] = c.resolveBackupTarget(gctx, repoPath)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	owners := make(map[string]string, len(repos))
+	for i, repoPath := range repos {
+		if targets[i].Err != nil || targets[i].Key == "" {
+			continue
+		}
+		if owner, ok := owners[targets[i].Key]; ok {
+			targets[i].Owner = owner
+			continue
+		}
+		owners[targets[i].Key] = RelativePath(rootDir, repoPath)
+	}
+
+	return targets
+}
+
+// resolveBackupTarget reads the origin remote of repoPath and derives its mirror key.
+// A repository without origin yields an empty target.
+func (c *client) resolveBackupTarget(ctx context.Context, repoPath string) backupTarget {
+	remoteURL, err := c.executor.RunOutput(ctx, repoPath, "remote", "get-url", "origin")
+	if err != nil || remoteURL == "" {
+		return backupTarget{}
+	}
+	// Local remotes may be relative to the repository
+	if !strings.Contains(remoteURL, "://") && RemoteHost(remoteURL) == "" && !filepath.IsAbs(remoteURL) {
+		remoteURL = filepath.Join(repoPath, remoteURL)
+	}
+
+	key, err := backupKey(remoteURL)
+	return backupTarget{RemoteURL: remoteURL, Key: key, Err: err}
+}
+
+// processBackupRepositories backs up multiple repositories in parallel
+func (c *client) processBackupRepositories(ctx context.Context, rootDir string, repos []string, opts BulkBackupOptions, logger Logger) ([]RepositoryBackupResult, error) {
+	results := make([]RepositoryBackupResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	targets := c.planBackupTargets(ctx, rootDir, repos, opts.Parallel)
+
+	// Only mirrors that are created or updated contact the remote
+	var remoteURLs []string
+	if hosts != nil && !opts.DryRun {
+		remoteURLs = make([]string, len(targets))
+		for i, target := range targets {
+			if target.Owner == "" {
+				remoteURLs[i] = target.RemoteURL
+			}
+		}
+	}
+
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
+
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+
+		result := c.processBackupRepository(ctx, rootDir, repoPath, targets[i], opts, logger)
+
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
+
+	return results, nil
+}
+
+// processBackupRepository backs up a single repository
+func (c *client) processBackupRepository(ctx context.Context, rootDir, repoPath string, target backupTarget, opts BulkBackupOptions, logger Logger) RepositoryBackupResult {
+	startTime := time.Now()
+
+	result := RepositoryBackupResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	c.backupRepository(ctx, target, opts, logger, &result)
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// backupRepository creates or updates the mirror planned for a repository
+func (c *client) backupRepository(ctx context.Context, target backupTarget, opts BulkBackupOptions, logger Logger, result *RepositoryBackupResult) {
+	if target.RemoteURL == "" {
+		result.Status = StatusNoRemote
+		result.Message = "No origin remote to mirror"
+		return
+	}
+	remoteURL, key := target.RemoteURL, target.Key
+	result.RemoteURL = redactRemoteURL(remoteURL)
+
+	if target.Err != nil {
+		result.Status = StatusError
+		result.Message = "Cannot derive mirror path from remote URL"
+		result.Error = target.Err
+		return
+	}
+	mirrorName := key + ".git"
+	result.MirrorPath = filepath.Join(opts.Destination, filepath.FromSlash(mirrorName))
+
+	if target.Owner != "" {
+		result.Status = StatusSkipped
+		result.Message = fmt.Sprintf("Same remote as %s (mirror %s)", target.Owner, mirrorName)
+		return
+	}
+
+	exists := false
+	if _, err := os.Stat(result.MirrorPath); err == nil {
+		if !isBareRepository(result.MirrorPath) {
+			result.Status = StatusError
+			result.Message = fmt.Sprintf("%s exists but is not a repository", mirrorName)
+			result.Error = fmt.Errorf("not a git repository: %s", result.MirrorPath)
+			return
+		}
+		exists = true
+	}
+
+	// Dry run mode
+	if opts.DryRun {
+		if exists {
+			result.Status = StatusWouldUpdate
+			result.Message = fmt.Sprintf("Would update mirror %s", mirrorName)
+		} else {
+			result.Status = StatusWouldClone
+			result.Message = fmt.Sprintf("Would create mirror %s", mirrorName)
+		}
+		return
+	}
+
+	if exists {
+		c.updateMirror(ctx, result.MirrorPath, remoteURL, mirrorName, logger, result)
+	} else {
+		c.createMirror(ctx, result.MirrorPath, remoteURL, mirrorName, logger, result)
+	}
+	if result.Status == StatusError || opts.BundleDir == "" {
+		return
+	}
+
+	// Bundle new and changed mirrors, and mirrors without a bundle yet
+	bundlePath := filepath.Join(opts.BundleDir, filepath.FromSlash(key)+".bundle")
+	if _, err := os.Stat(bundlePath); err == nil && result.Status == StatusUpToDate {
+		return
+	}
+	written, err := c.writeMirrorBundle(ctx, result.MirrorPath, bundlePath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message += "; failed to write bundle"
+		result.Error = err
+		logger.Error("bundle failed", "path", result.RelativePath, "error", err)
+		return
+	}
+	if written {
+		result.BundlePath = bundlePath
+		result.Message += ", bundle written"
+	}
+}
+
+// createMirror clones a new bare mirror of remoteURL
+func (c *client) createMirror(ctx context.Context, mirrorPath, remoteURL, mirrorName string, logger Logger, result *RepositoryBackupResult) {
+	logger.Info("creating mirror", "path", result.RelativePath, "mirror", mirrorName)
+
+	if _, err := c.Clone(ctx, CloneOptions{
+		URL:         remoteURL,
+		Destination: mirrorPath,
+		Mirror:      true,
+		Quiet:       true,
+		Logger:      logger,
+	}); err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to create mirror"
+		result.Error = err
+		logger.Error("mirror clone failed", "path", result.RelativePath, "error", err)
+		return
+	}
+
+	result.Status = StatusCloned
+	result.Message = fmt.Sprintf("Created mirror %s", mirrorName)
+}
+
+// updateMirror fetches all refs of an existing mirror, pruning deleted ones
+func (c *client) updateMirror(ctx context.Context, mirrorPath, remoteURL, mirrorName string, logger Logger, result *RepositoryBackupResult) {
+	// Follow URL changes of the local clone, e.g. a move from SSH to HTTPS
+	if current, _ := c.executor.RunOutput(ctx, mirrorPath, "remote", "get-url", "origin"); current != remoteURL {
+		if _, err := c.executor.RunOutput(ctx, mirrorPath, "remote", "set-url", "origin", remoteURL); err != nil {
+			logger.Warn("failed to update mirror URL", "mirror", mirrorName, "error", err)
+		}
+	}
+
+	before := c.mirrorRefs(ctx, mirrorPath)
+	fetchResult, err := c.executor.Run(ctx, mirrorPath, "fetch", "--prune", "origin")
+	if err == nil && fetchResult.ExitCode != 0 {
+		err = fmt.Errorf("fetch exited with code %d: %s", fetchResult.ExitCode, strings.TrimSpace(fetchResult.Stderr))
+	}
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to update mirror"
+		result.Error = err
+		logger.Error("mirror fetch failed", "path", result.RelativePath, "error", err)
+		return
+	}
+
+	if c.mirrorRefs(ctx, mirrorPath) == before {
+		result.Status = StatusUpToDate
+		result.Message = fmt.Sprintf("Mirror %s is up to date", mirrorName)
+		return
+	}
+	result.Status = StatusUpdated
+	result.Message = fmt.Sprintf("Updated mirror %s", mirrorName)
+}
+
+// mirrorRefs returns all refs of a mirror with their objects, for detecting changes
+func (c *client) mirrorRefs(ctx context.Context, mirrorPath string) string {
+	refs, _ := c.executor.RunOutput(ctx, mirrorPath, "for-each-ref", "--format=%(objectname) %(refname)")
+	return refs
+}
+
+// writeMirrorBundle writes a bundle of all refs of a mirror. The bundle is
+// written to a temporary file first, so an existing bundle is only replaced
+// by a complete one. Mirrors without refs have nothing to bundle.
+func (c *client) writeMirrorBundle(ctx context.Context, mirrorPath, bundlePath string) (bool, error) {
+	if c.mirrorRefs(ctx, mirrorPath) == "" {
+		return false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(bundlePath), 0o755); err != nil {
+		return false, fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+
+	tmpPath := bundlePath + ".tmp"
+	bundleResult, err := c.executor.Run(ctx, mirrorPath, "bundle", "create", tmpPath, "--all")
+	if err == nil && bundleResult.ExitCode != 0 {
+		err = fmt.Errorf("bundle create exited with code %d: %s", bundleResult.ExitCode, strings.TrimSpace(bundleResult.Stderr))
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return false, err
+	}
+	if err := os.Rename(tmpPath, bundlePath); err != nil {
+		os.Remove(tmpPath)
+		return false, fmt.Errorf("failed to replace bundle: %w", err)
+	}
+	return true, nil
+}
+
+// backupKey derives the mirror location of a remote URL: its host and path
+// without ".git", e.g. "github.com/org/repo" for both
+// https://github.com/org/repo.git and git@github.com:org/repo.git.
+// Local and file:// remotes are stored under "local".
+func backupKey(remoteURL string) (string, error) {
+	var host, repoPath string
+	switch {
+	case filepath.IsAbs(remoteURL):
+		host, repoPath = "local", filepath.ToSlash(remoteURL)
+	case strings.Contains(remoteURL, "://"):
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid remote URL: %w", err)
+		}
+		host, repoPath = normalizeHost(u.Host), u.Path
+		if u.Scheme == "file" {
+			host = "local"
+		}
+	default:
+		host = RemoteHost(remoteURL)
+		_, repoPath, _ = strings.Cut(remoteURL, ":")
+	}
+
+	// Cleaning a rooted path drops any ".." that would leave the destination
+	repoPath = strings.Trim(strings.TrimSuffix(path.Clean("/"+repoPath), ".git"), "/")
+	if host == "" || repoPath == "" {
+		return "", fmt.Errorf("cannot derive mirror path from remote URL %s", redactRemoteURL(remoteURL))
+	}
+	return host + "/" + repoPath, nil
+}
+
+// calculateBackupSummary creates a summary of backup results by status
+func calculateBackupSummary(results []RepositoryBackupResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
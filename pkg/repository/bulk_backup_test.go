@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBackupKey(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{url: "https://github.com/org/repo.git", want: "github.com/org/repo"},
+		{url: "git@github.com:org/repo.git", want: "github.com/org/repo"},
+		{url: "ssh://git@GitHub.com:22/org/repo", want: "github.com/org/repo"},
+		{url: "https://token@gitlab.example.com/group/sub/repo.git", want: "gitlab.example.com/group/sub/repo"},
+		{url: "https://github.com/org/../../etc/repo.git", want: "github.com/etc/repo"},
+		{url: "file:///srv/git/repo.git", want: "local/srv/git/repo"},
+		{url: "/srv/git/repo.git", want: "local/srv/git/repo"},
+		{url: "https://github.com/", wantErr: true},
+		{url: "repo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := backupKey(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("backupKey(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("backupKey(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestBulkBackup(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	backupDir := filepath.Join(tmpDir, "backup")
+	bundleDir := filepath.Join(tmpDir, "bundles")
+
+	// app and app-copy share a remote; local has none
+	remote := filepath.Join(tmpDir, "app.git")
+	initTagRepo(t, filepath.Join(workDir, "app"), remote)
+	gitOutput(t, filepath.Join(workDir, "app"), "push", "-q", "-u", "origin", "HEAD")
+	gitOutput(t, tmpDir, "clone", "-q", remote, filepath.Join(workDir, "app-copy"))
+	initTagRepo(t, filepath.Join(workDir, "local"), "")
+
+	key, err := backupKey(remote)
+	if err != nil {
+		t.Fatalf("backupKey failed: %v", err)
+	}
+	mirrorPath := filepath.Join(backupDir, filepath.FromSlash(key)+".git")
+	bundlePath := filepath.Join(bundleDir, filepath.FromSlash(key)+".bundle")
+
+	backup := func(dryRun bool) *BulkBackupResult {
+		t.Helper()
+		result, err := NewClient().BulkBackup(context.Background(), BulkBackupOptions{
+			Directory:   workDir,
+			Destination: backupDir,
+			BundleDir:   bundleDir,
+			DryRun:      dryRun,
+		})
+		if err != nil {
+			t.Fatalf("BulkBackup failed: %v", err)
+		}
+		return result
+	}
+	find := func(result *BulkBackupResult, relPath string) RepositoryBackupResult {
+		t.Helper()
+		for _, repo := range result.Repositories {
+			if repo.RelativePath == relPath {
+				return repo
+			}
+		}
+		t.Fatalf("no result for %s", relPath)
+		return RepositoryBackupResult{}
+	}
+
+	// Dry run creates nothing
+	result := backup(true)
+	if result.Summary[StatusWouldClone] != 1 || result.Summary[StatusSkipped] != 1 || result.Summary[StatusNoRemote] != 1 {
+		t.Fatalf("dry run: unexpected summary: %v", result.Summary)
+	}
+	if _, err := os.Stat(backupDir); !os.IsNotExist(err) {
+		t.Errorf("dry run created %s", backupDir)
+	}
+
+	// First run creates the mirror and a bundle; the first repository in scan order owns it
+	result = backup(false)
+	app := find(result, "app")
+	if app.Status != StatusCloned || app.MirrorPath != mirrorPath || app.BundlePath != bundlePath {
+		t.Fatalf("first run: unexpected result: %+v", app)
+	}
+	if shared := find(result, "app-copy"); shared.Status != StatusSkipped || shared.MirrorPath != mirrorPath {
+		t.Errorf("first run: shared remote not skipped: %+v", shared)
+	}
+	if local := find(result, "local"); local.Status != StatusNoRemote {
+		t.Errorf("first run: local status = %s, want %s", local.Status, StatusNoRemote)
+	}
+	if gitOutput(t, mirrorPath, "rev-parse", "--is-bare-repository") != "true" {
+		t.Errorf("mirror is not bare")
+	}
+	if _, err := os.Stat(bundlePath); err != nil {
+		t.Errorf("bundle not written: %v", err)
+	}
+	gitOutput(t, mirrorPath, "bundle", "verify", "-q", bundlePath)
+
+	// Second run has nothing to fetch and keeps the bundle
+	result = backup(false)
+	if app := find(result, "app"); app.Status != StatusUpToDate || app.BundlePath != "" {
+		t.Errorf("second run: unexpected result: %+v", app)
+	}
+
+	// New commits and deleted branches reach the mirror
+	gitOutput(t, filepath.Join(workDir, "app"), "push", "-q", "origin", "HEAD:refs/heads/feature")
+	result = backup(false)
+	if app := find(result, "app"); app.Status != StatusUpdated || app.BundlePath != bundlePath {
+		t.Errorf("after push: unexpected result: %+v", app)
+	}
+	gitOutput(t, mirrorPath, "rev-parse", "--verify", "-q", "refs/heads/feature")
+
+	gitOutput(t, filepath.Join(workDir, "app"), "push", "-q", "origin", "--delete", "feature")
+	if app := find(backup(false), "app"); app.Status != StatusUpdated {
+		t.Errorf("after delete: status = %s, want %s", app.Status, StatusUpdated)
+	}
+	if gitOutput(t, mirrorPath, "for-each-ref", "refs/heads/feature") != "" {
+		t.Errorf("deleted branch was not pruned from the mirror")
+	}
+
+	// An existing mirror is reported as would-update
+	if app := find(backup(true), "app"); app.Status != StatusWouldUpdate {
+		t.Errorf("dry run: status = %s, want %s", app.Status, StatusWouldUpdate)
+	}
+}
+
+func TestBulkBackupRequiresDestination(t *testing.T) {
+	_, err := NewClient().BulkBackup(context.Background(), BulkBackupOptions{Directory: t.TempDir()})
+	if err == nil {
+		t.Fatal("expected error without destination")
+	}
+}
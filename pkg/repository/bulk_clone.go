@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cloneTarget is a planned clone of a single URL.
+type cloneTarget struct {
+	URL  string
+	Path string
+	Err  error
+}
+
+// BulkClone clones a list of repositories into a directory in parallel.
+// Destinations are derived from the repository names in the URLs.
+// Existing repositories at a destination are skipped, not updated.
+func (c *client) BulkClone(ctx context.Context, opts BulkCloneOptions) (*BulkCloneResult, error) {
+	startTime := time.Now()
+
+	urls := append([]string{}, opts.URLs...)
+	if opts.URLFile != "" {
+		fileURLs, err := readURLFile(opts.URLFile)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, fileURLs...)
+	}
+
+	// Validate required options
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("at least one repository URL is required")
+	}
+	if err := ValidateCloneFilter(opts.Filter); err != nil {
+		return nil, err
+	}
+
+	// Set defaults
+	if opts.Directory == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current directory: %w", err)
+		}
+		opts.Directory = cwd
+	}
+
+	absPath, err := filepath.Abs(opts.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+	opts.Directory = absPath
+
+	if opts.Parallel <= 0 {
+		opts.Parallel = DefaultBulkParallel
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = &noopLogger{}
+	}
+
+	if !opts.DryRun {
+		if err := os.MkdirAll(opts.Directory, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+
+	targets := planCloneTargets(opts.Directory, urls)
+
+	// Process repositories in parallel
+	results, err := c.processCloneRepositories(ctx, targets, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkCloneResult{
+		TotalRequested: len(urls),
+		TotalProcessed: len(results),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateCloneSummary(results),
+	}, nil
+}
+
+// readURLFile reads repository URLs from a file, one per line.
+// Blank lines and lines starting with '#' are ignored.
+func readURLFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open URL file: %w", err)
+	}
+	defer file.Close()
+
+	var urls []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read URL file: %w", err)
+	}
+
+	return urls, nil
+}
+
+// planCloneTargets derives a destination for each URL.
+// URLs whose name cannot be extracted, or whose destination collides
+// with an earlier URL, are marked with an error.
+func planCloneTargets(root string, urls []string) []cloneTarget {
+	targets := make([]cloneTarget, len(urls))
+	seen := make(map[string]string, len(urls))
+
+	for i, url := range urls {
+		targets[i].URL = url
+
+		name, err := ExtractRepoNameFromURL(url)
+		if err != nil {
+			targets[i].Path = filepath.Join(root, url)
+			targets[i].Err = err
+			continue
+		}
+
+		path := filepath.Join(root, name)
+		targets[i].Path = path
+
+		if prev, ok := seen[path]; ok {
+			targets[i].Err = fmt.Errorf("destination '%s' is already used by %s", name, prev)
+			continue
+		}
+		seen[path] = url
+	}
+
+	return targets
+}
+
+// processCloneRepositories clones repositories in parallel
+func (c *client) processCloneRepositories(ctx context.Context, targets []cloneTarget, opts BulkCloneOptions) ([]RepositoryCloneResult, error) {
+	results := make([]RepositoryCloneResult, len(targets))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(targets))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := make([]string, len(targets))
+	for i, target := range targets {
+		remoteURLs[i] = target.URL
+	}
+
+	// Clone repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(targets), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		target := targets[i]
+
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(targets), target.URL)
+		}
+		events.Started(i+1, target.URL)
+		result := c.processCloneRepository(ctx, target, opts)
+
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, target.URL, result.Status, result.Duration)
+		mu.Unlock()
+	})
+
+	return results, nil
+}
+
+// processCloneRepository clones a single repository
+func (c *client) processCloneRepository(ctx context.Context, target cloneTarget, opts BulkCloneOptions) RepositoryCloneResult {
+	startTime := time.Now()
+	logger := opts.Logger
+
+	result := RepositoryCloneResult{
+		Path:         target.Path,
+		RelativePath: RelativePath(opts.Directory, target.Path),
+		URL:          target.URL,
+	}
+
+	if target.Err != nil {
+		result.Status = StatusError
+		result.Message = "Invalid repository URL or destination"
+		result.Error = target.Err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	exists, isGitRepo, err := checkTargetDirectory(target.Path)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to check destination"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	if exists {
+		if isGitRepo {
+			result.Status = StatusSkipped
+			result.Message = "Repository already exists"
+			result.Duration = time.Since(startTime)
+			logger.Info("skipping existing repository", "path", result.RelativePath)
+			return result
+		}
+
+		if entries, err := os.ReadDir(target.Path); err != nil || len(entries) > 0 {
+			result.Status = StatusError
+			result.Message = "Destination exists and is not an empty directory"
+			result.Error = fmt.Errorf("destination '%s' exists and is not a git repository", target.Path)
+			result.Duration = time.Since(startTime)
+			return result
+		}
+	}
+
+	// Dry run mode
+	if opts.DryRun {
+		result.Status = StatusWouldClone
+		result.Message = fmt.Sprintf("Would clone %s", target.URL)
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	logger.Info("cloning repository", "url", target.URL, "path", result.RelativePath)
+
+	_, err = c.Clone(ctx, CloneOptions{
+		URL:          target.URL,
+		Destination:  target.Path,
+		Branch:       opts.Branch,
+		Depth:        opts.Depth,
+		SingleBranch: opts.SingleBranch,
+		Filter:       opts.Filter,
+		SparsePaths:  opts.SparsePaths,
+		Quiet:        true,
+		Logger:       logger,
+	})
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Clone failed"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		logger.Error("clone failed", "url", target.URL, "error", err)
+		return result
+	}
+
+	result.Status = StatusCloned
+	result.Message = fmt.Sprintf("Cloned into %s", result.RelativePath)
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// calculateCloneSummary creates a summary of clone results by status
+func calculateCloneSummary(results []RepositoryCloneResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBulkCloneRequiresURLs(t *testing.T) {
+	client := NewClient()
+
+	if _, err := client.BulkClone(context.Background(), BulkCloneOptions{Directory: t.TempDir()}); err == nil {
+		t.Error("expected error when no URLs are given")
+	}
+}
+
+func TestReadURLFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repos.txt")
+	content := "# team repositories\nhttps://github.com/user/api.git\n\n  git@github.com:user/web.git  \n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write URL file: %v", err)
+	}
+
+	urls, err := readURLFile(path)
+	if err != nil {
+		t.Fatalf("readURLFile failed: %v", err)
+	}
+
+	want := []string{"https://github.com/user/api.git", "git@github.com:user/web.git"}
+	if len(urls) != len(want) {
+		t.Fatalf("expected %d URLs, got %v", len(want), urls)
+	}
+	for i := range want {
+		if urls[i] != want[i] {
+			t.Errorf("urls[%d] = %q, want %q", i, urls[i], want[i])
+		}
+	}
+
+	if _, err := readURLFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestPlanCloneTargets(t *testing.T) {
+	targets := planCloneTargets("/work", []string{
+		"https://github.com/user/api.git",
+		"git@gitlab.com:other/api.git",
+		"https://github.com/user/web",
+	})
+
+	if targets[0].Path != "/work/api" || targets[0].Err != nil {
+		t.Errorf("unexpected first target: %+v", targets[0])
+	}
+	if targets[1].Err == nil {
+		t.Error("expected duplicate destination error for second target")
+	}
+	if targets[2].Path != "/work/web" || targets[2].Err != nil {
+		t.Errorf("unexpected third target: %+v", targets[2])
+	}
+}
+
+func TestBulkClone(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Create source repositories to clone from
+	var urls []string
+	for _, name := range []string{"alpha", "beta"} {
+		sourcePath := filepath.Join(tmpDir, "sources", name)
+		if err := os.MkdirAll(sourcePath, 0o755); err != nil {
+			t.Fatalf("Failed to create source: %v", err)
+		}
+		if err := initGitRepoWithCommit(sourcePath); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+		urls = append(urls, sourcePath)
+	}
+
+	urlFile := filepath.Join(tmpDir, "repos.txt")
+	if err := os.WriteFile(urlFile, []byte(urls[1]+"\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write URL file: %v", err)
+	}
+
+	target := filepath.Join(tmpDir, "workspace")
+	ctx := context.Background()
+	client := NewClient()
+
+	opts := BulkCloneOptions{
+		URLs:      urls[:1],
+		URLFile:   urlFile,
+		Directory: target,
+		Parallel:  2,
+		DryRun:    true,
+	}
+
+	// Dry run reports clones without touching the filesystem
+	result, err := client.BulkClone(ctx, opts)
+	if err != nil {
+		t.Fatalf("BulkClone dry-run failed: %v", err)
+	}
+	if result.TotalRequested != 2 || result.Summary[StatusWouldClone] != 2 {
+		t.Errorf("unexpected dry-run result: requested=%d summary=%v", result.TotalRequested, result.Summary)
+	}
+	if _, err := os.Stat(target); !os.IsNotExist(err) {
+		t.Error("dry-run should not create the directory")
+	}
+
+	// Real clone
+	opts.DryRun = false
+	result, err = client.BulkClone(ctx, opts)
+	if err != nil {
+		t.Fatalf("BulkClone failed: %v", err)
+	}
+	if result.Summary[StatusCloned] != 2 {
+		t.Fatalf("expected 2 cloned results, got %v (%+v)", result.Summary, result.Repositories)
+	}
+	for _, name := range []string{"alpha", "beta"} {
+		if !client.IsRepository(ctx, filepath.Join(target, name)) {
+			t.Errorf("expected repository at %s", name)
+		}
+	}
+
+	// Second run skips existing repositories
+	result, err = client.BulkClone(ctx, opts)
+	if err != nil {
+		t.Fatalf("second BulkClone failed: %v", err)
+	}
+	if result.Summary[StatusSkipped] != 2 {
+		t.Errorf("expected 2 skipped results, got %v", result.Summary)
+	}
+}
+
+func TestBulkCloneNonEmptyDestination(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	blocked := filepath.Join(tmpDir, "repo")
+	if err := os.MkdirAll(blocked, 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(blocked, "file.txt"), []byte("data"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	client := NewClient()
+	result, err := client.BulkClone(context.Background(), BulkCloneOptions{
+		URLs:      []string{"https://example.com/user/repo.git"},
+		Directory: tmpDir,
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatalf("BulkClone failed: %v", err)
+	}
+
+	if result.Repositories[0].Status != StatusError {
+		t.Errorf("expected error status, got %s", result.Repositories[0].Status)
+	}
+}
@@ -0,0 +1,541 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HealthCheck identifies a repository health check run by BulkDoctor.
+type HealthCheck string
+
+const (
+	// HealthRebaseInProgress reports a rebase that was started but not finished
+	HealthRebaseInProgress HealthCheck = "rebase-in-progress"
+
+	// HealthMergeInProgress reports a merge that was started but not finished
+	HealthMergeInProgress HealthCheck = "merge-in-progress"
+
+	// HealthNoRemote reports a repository without the checked remote
+	HealthNoRemote HealthCheck = "no-remote"
+
+	// HealthDetachedHead reports a HEAD that is not on a branch
+	HealthDetachedHead HealthCheck = "detached-head"
+
+	// HealthMissingUpstream reports a branch without an upstream, or whose upstream is gone
+	HealthMissingUpstream HealthCheck = "missing-upstream"
+
+	// HealthDiverged reports a branch with both local and upstream commits the other lacks
+	HealthDiverged HealthCheck = "diverged"
+
+	// HealthDefaultBranch reports an unknown or outdated remote default branch,
+	// or a local default branch that differs from the remote one
+	HealthDefaultBranch HealthCheck = "default-branch"
+
+	// HealthOldStash reports stash entries older than StashMaxAge
+	HealthOldStash HealthCheck = "old-stash"
+
+	// HealthShallow reports a shallow clone
+	HealthShallow HealthCheck = "shallow"
+
+	// HealthStaleRemoteBranches reports remote-tracking branches deleted on the remote (Online only)
+	HealthStaleRemoteBranches HealthCheck = "stale-remote-branches"
+)
+
+// HealthSeverity is how serious a health issue is.
+type HealthSeverity string
+
+const (
+	// SeverityError blocks bulk operations such as pull and switch
+	SeverityError HealthSeverity = "error"
+
+	// SeverityWarning makes bulk operations skip the repository or behave unexpectedly
+	SeverityWarning HealthSeverity = "warning"
+
+	// SeverityInfo is worth knowing but needs no action
+	SeverityInfo HealthSeverity = "info"
+)
+
+// DefaultStashMaxAge is the default age from which BulkDoctor reports stash entries as old.
+const DefaultStashMaxAge = 90 * 24 * time.Hour
+
+// HealthIssue is a problem found by a health check.
+type HealthIssue struct {
+	// Check is the check that found the issue
+	Check HealthCheck
+
+	// Severity is how serious the issue is
+	Severity HealthSeverity
+
+	// Message describes the issue
+	Message string
+
+	// Hint suggests how to resolve the issue manually
+	Hint string
+
+	// Fixable indicates BulkDoctor can fix the issue safely
+	Fixable bool
+
+	// Fixed indicates the issue was fixed
+	Fixed bool
+
+	// FixError is the error of a failed fix
+	FixError error
+
+	// fix applies the remedy; set for fixable issues
+	fix func(ctx context.Context) error
+}
+
+// blocking reports whether the issue needs attention: unfixed and more than informational
+func (i HealthIssue) blocking() bool {
+	return !i.Fixed && i.Severity != SeverityInfo
+}
+
+// BulkDoctor scans for repositories and checks each for common problems:
+// unfinished rebases and merges, a missing remote, detached HEAD, missing or
+// gone upstreams, diverged branches, default branch mismatches, old stash
+// entries and shallow clones.
+//
+// The checks only read local state unless Online is set. With Fix, issues with
+// a safe remedy are fixed: the upstream is set to the remote branch of the same
+// name, the remote default branch is updated and stale remote-tracking branches
+// are pruned. Nothing that could lose work is changed.
+func (c *client) BulkDoctor(ctx context.Context, opts BulkDoctorOptions) (*BulkDoctorResult, error) {
+	startTime := time.Now()
+
+	// Apply defaults
+	if opts.Remote == "" {
+		opts.Remote = "origin"
+	}
+	if strings.HasPrefix(opts.Remote, "-") || strings.ContainsAny(opts.Remote, " \t") {
+		return nil, &ValidationError{Field: "remote", Value: opts.Remote, Reason: "invalid remote name"}
+	}
+	if opts.StashMaxAge <= 0 {
+		opts.StashMaxAge = DefaultStashMaxAge
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkDoctorResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryDoctorResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Issues:         map[HealthCheck]int{},
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processDoctorRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	result := &BulkDoctorResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateDoctorSummary(results),
+		Issues:         map[HealthCheck]int{},
+	}
+	for _, repo := range results {
+		for _, issue := range repo.Issues {
+			if issue.Fixed {
+				result.Fixed++
+			} else {
+				result.Issues[issue.Check]++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// processDoctorRepositories checks multiple repositories in parallel
+func (c *client) processDoctorRepositories(ctx context.Context, rootDir string, repos []string, opts BulkDoctorOptions, logger Logger) ([]RepositoryDoctorResult, error) {
+	results := make([]RepositoryDoctorResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := c.remoteURLs(ctx, hosts, repos, opts.Remote, opts.Parallel)
+
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
+
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+
+		result := c.processDoctorRepository(ctx, rootDir, repoPath, opts, logger)
+
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
+
+	return results, nil
+}
+
+// processDoctorRepository checks a single repository and applies fixes
+func (c *client) processDoctorRepository(ctx context.Context, rootDir, repoPath string, opts BulkDoctorOptions, logger Logger) RepositoryDoctorResult {
+	startTime := time.Now()
+
+	result := RepositoryDoctorResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	issues, err := c.checkRepositoryHealth(ctx, repoPath, opts, &result)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to check repository"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	if opts.Fix {
+		for i := range issues {
+			if !issues[i].Fixable {
+				continue
+			}
+			if err := issues[i].fix(ctx); err != nil {
+				issues[i].FixError = err
+				logger.Warn("fix failed", "path", result.RelativePath, "check", issues[i].Check, "error", err)
+				continue
+			}
+			issues[i].Fixed = true
+			logger.Info("issue fixed", "path", result.RelativePath, "check", issues[i].Check)
+		}
+	}
+	result.Issues = issues
+
+	var open, fixed []string
+	for _, issue := range issues {
+		switch {
+		case issue.Fixed:
+			fixed = append(fixed, string(issue.Check))
+		case issue.blocking():
+			open = append(open, string(issue.Check))
+		}
+	}
+
+	switch {
+	case len(open) > 0:
+		result.Status = StatusUnhealthy
+		result.Message = fmt.Sprintf("%d %s: %s", len(open), PluralSuffix(len(open), "issue", "issues"), strings.Join(open, ", "))
+	case len(fixed) > 0:
+		result.Status = StatusFixed
+		result.Message = "Fixed " + strings.Join(fixed, ", ")
+	default:
+		result.Status = StatusHealthy
+		result.Message = "Healthy"
+	}
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// checkRepositoryHealth runs the health checks of a single repository.
+// Remote-dependent checks are skipped when the remote is not configured.
+func (c *client) checkRepositoryHealth(ctx context.Context, repoPath string, opts BulkDoctorOptions, result *RepositoryDoctorResult) ([]HealthIssue, error) {
+	var issues []HealthIssue
+
+	// Unfinished operations
+	if IsRebaseInProgress(repoPath) {
+		issues = append(issues, HealthIssue{
+			Check:    HealthRebaseInProgress,
+			Severity: SeverityError,
+			Message:  "A rebase is in progress",
+			Hint:     "git rebase --continue, or git rebase --abort",
+		})
+	}
+	if IsMergeInProgress(repoPath) {
+		issues = append(issues, HealthIssue{
+			Check:    HealthMergeInProgress,
+			Severity: SeverityError,
+			Message:  "A merge is in progress",
+			Hint:     "git commit to conclude the merge, or git merge --abort",
+		})
+	}
+
+	// Current branch; empty when HEAD is detached
+	branch, err := c.executor.RunOutput(ctx, repoPath, "branch", "--show-current")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+	result.Branch = branch
+	if branch == "" {
+		issues = append(issues, HealthIssue{
+			Check:    HealthDetachedHead,
+			Severity: SeverityWarning,
+			Message:  "HEAD is detached",
+			Hint:     "git switch <branch>",
+		})
+	}
+
+	// Remote
+	remotes, err := c.executor.RunLines(ctx, repoPath, "remote")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+	hasRemote := false
+	for _, remote := range remotes {
+		if remote == opts.Remote {
+			hasRemote = true
+		}
+	}
+	if !hasRemote {
+		issue := HealthIssue{
+			Check:    HealthNoRemote,
+			Severity: SeverityWarning,
+			Message:  "No remote configured",
+			Hint:     fmt.Sprintf("git remote add %s <url>", opts.Remote),
+		}
+		if len(remotes) > 0 {
+			issue.Message = fmt.Sprintf("Remote '%s' is not configured (remotes: %s)", opts.Remote, strings.Join(remotes, ", "))
+		}
+		issues = append(issues, issue)
+	}
+
+	// Upstream and divergence
+	if hasRemote && branch != "" {
+		issues = append(issues, c.checkUpstream(ctx, repoPath, branch, opts.Remote)...)
+	}
+
+	// Default branch
+	if hasRemote {
+		if issue, ok := c.checkDefaultBranch(ctx, repoPath, opts.Remote); ok {
+			issues = append(issues, issue)
+		}
+	}
+
+	// Old stash entries
+	if stashes, err := c.listStashes(ctx, repoPath); err == nil {
+		cutoff := time.Now().Add(-opts.StashMaxAge)
+		old := 0
+		var oldest time.Time
+		for _, entry := range stashes {
+			if !entry.CreatedAt.IsZero() && entry.CreatedAt.Before(cutoff) {
+				old++
+				if oldest.IsZero() || entry.CreatedAt.Before(oldest) {
+					oldest = entry.CreatedAt
+				}
+			}
+		}
+		if old > 0 {
+			issues = append(issues, HealthIssue{
+				Check:    HealthOldStash,
+				Severity: SeverityInfo,
+				Message:  fmt.Sprintf("%d %s older than %d days (oldest from %s)", old, PluralSuffix(old, "stash entry", "stash entries"), int(opts.StashMaxAge.Hours()/24), oldest.Format("2006-01-02")),
+				Hint:     "git stash list; drop entries you no longer need with git stash drop",
+			})
+		}
+	}
+
+	// Shallow clone
+	if shallow, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--is-shallow-repository"); err == nil && shallow == "true" {
+		issues = append(issues, HealthIssue{
+			Check:    HealthShallow,
+			Severity: SeverityInfo,
+			Message:  "Shallow clone: history is incomplete",
+			Hint:     "git fetch --unshallow",
+		})
+	}
+
+	// Stale remote-tracking branches (contacts the remote)
+	if hasRemote && opts.Online {
+		issue, ok, err := c.checkStaleRemoteBranches(ctx, repoPath, opts.Remote)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+// checkUpstream checks that the current branch has an existing upstream and has not diverged from it
+func (c *client) checkUpstream(ctx context.Context, repoPath, branch, remote string) []HealthIssue {
+	if _, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--abbrev-ref", "@{upstream}"); err != nil {
+		issue := HealthIssue{
+			Check:    HealthMissingUpstream,
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("Branch '%s' has no upstream", branch),
+			Hint:     fmt.Sprintf("git push -u %s %s", remote, branch),
+		}
+		if merge, err := c.executor.RunOutput(ctx, repoPath, "config", "branch."+branch+".merge"); err == nil && merge != "" {
+			issue.Message = fmt.Sprintf("Upstream of '%s' (%s) is gone", branch, strings.TrimPrefix(merge, "refs/heads/"))
+		}
+
+		// The remote branch of the same name is the obvious upstream
+		remoteBranch := remote + "/" + branch
+		if exists, _ := c.executor.RunQuiet(ctx, repoPath, "rev-parse", "--verify", "--quiet", "refs/remotes/"+remoteBranch); exists {
+			issue.Fixable = true
+			issue.Hint = "git branch --set-upstream-to=" + remoteBranch
+			issue.fix = func(ctx context.Context) error {
+				return c.runFix(ctx, repoPath, "branch", "--set-upstream-to="+remoteBranch)
+			}
+		}
+		return []HealthIssue{issue}
+	}
+
+	output, err := c.executor.RunOutput(ctx, repoPath, "rev-list", "--left-right", "--count", "HEAD...@{upstream}")
+	if err != nil {
+		return nil
+	}
+	ahead, behind, err := parseAheadBehind(output)
+	if err != nil || ahead == 0 || behind == 0 {
+		return nil
+	}
+	return []HealthIssue{{
+		Check:    HealthDiverged,
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf("Branch '%s' has diverged from its upstream (%d ahead, %d behind)", branch, ahead, behind),
+		Hint:     "git pull --rebase, or merge the upstream branch",
+	}}
+}
+
+// checkDefaultBranch checks that the remote default branch (<remote>/HEAD) is
+// known and exists, and that the local default branch has the same name
+func (c *client) checkDefaultBranch(ctx context.Context, repoPath string, remote string) (HealthIssue, bool) {
+	// Nothing to compare before the first fetch
+	remoteRefs, err := c.executor.RunLines(ctx, repoPath, "for-each-ref", "--format=%(refname)", "refs/remotes/"+remote+"/")
+	if err != nil || len(remoteRefs) == 0 {
+		return HealthIssue{}, false
+	}
+
+	setHead := HealthIssue{
+		Check:    HealthDefaultBranch,
+		Severity: SeverityWarning,
+		Hint:     fmt.Sprintf("git remote set-head %s --auto", remote),
+		Fixable:  true,
+		fix: func(ctx context.Context) error {
+			return c.runFix(ctx, repoPath, "remote", "set-head", remote, "--auto")
+		},
+	}
+
+	// Repositories created locally and pushed never had <remote>/HEAD set
+	headRef, err := c.executor.RunOutput(ctx, repoPath, "symbolic-ref", "--quiet", "refs/remotes/"+remote+"/HEAD")
+	if err != nil || headRef == "" {
+		setHead.Severity = SeverityInfo
+		setHead.Message = fmt.Sprintf("Remote default branch is unknown (%s/HEAD is not set)", remote)
+		return setHead, true
+	}
+	if exists, _ := c.executor.RunQuiet(ctx, repoPath, "rev-parse", "--verify", "--quiet", headRef); !exists {
+		setHead.Message = fmt.Sprintf("%s/HEAD points to %s, which no longer exists", remote, strings.TrimPrefix(headRef, "refs/remotes/"))
+		return setHead, true
+	}
+
+	// A local main when the remote default is master, or the other way around
+	defaultBranch := strings.TrimPrefix(headRef, "refs/remotes/"+remote+"/")
+	if exists, _ := c.executor.RunQuiet(ctx, repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+defaultBranch); exists {
+		return HealthIssue{}, false
+	}
+	for _, local := range []string{"main", "master"} {
+		if local == defaultBranch {
+			continue
+		}
+		if exists, _ := c.executor.RunQuiet(ctx, repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+local); exists {
+			return HealthIssue{
+				Check:    HealthDefaultBranch,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("Remote default branch is '%s', but the local one is '%s'", defaultBranch, local),
+				Hint:     fmt.Sprintf("git branch -m %s %s && git branch --set-upstream-to=%s/%s", local, defaultBranch, remote, defaultBranch),
+			}, true
+		}
+	}
+
+	return HealthIssue{}, false
+}
+
+// checkStaleRemoteBranches asks the remote which remote-tracking branches were deleted there
+func (c *client) checkStaleRemoteBranches(ctx context.Context, repoPath string, remote string) (HealthIssue, bool, error) {
+	pruneResult, err := c.executor.Run(ctx, repoPath, "remote", "prune", "--dry-run", remote)
+	if err != nil {
+		return HealthIssue{}, false, err
+	}
+	if pruneResult.ExitCode != 0 {
+		return HealthIssue{}, false, fmt.Errorf("remote prune --dry-run exited with code %d: %s", pruneResult.ExitCode, strings.TrimSpace(pruneResult.Stderr))
+	}
+
+	stale := strings.Count(pruneResult.Stdout, "[would prune]")
+	if stale == 0 {
+		return HealthIssue{}, false, nil
+	}
+
+	return HealthIssue{
+		Check:    HealthStaleRemoteBranches,
+		Severity: SeverityInfo,
+		Message:  fmt.Sprintf("%d remote-tracking %s deleted on %s", stale, PluralSuffix(stale, "branch", "branches"), remote),
+		Hint:     "git remote prune " + remote,
+		Fixable:  true,
+		fix: func(ctx context.Context) error {
+			return c.runFix(ctx, repoPath, "remote", "prune", remote)
+		},
+	}, true, nil
+}
+
+// runFix runs a git command that fixes an issue
+func (c *client) runFix(ctx context.Context, repoPath string, args ...string) error {
+	fixResult, err := c.executor.Run(ctx, repoPath, args...)
+	if err != nil {
+		return err
+	}
+	if fixResult.ExitCode != 0 {
+		return fmt.Errorf("git %s exited with code %d: %s", strings.Join(args[:2], " "), fixResult.ExitCode, strings.TrimSpace(fixResult.Stderr))
+	}
+	return nil
+}
+
+// calculateDoctorSummary creates a summary of health check results by status
+func calculateDoctorSummary(results []RepositoryDoctorResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+// hasIssue reports whether the result contains an issue found by check
+func hasIssue(result RepositoryDoctorResult, check HealthCheck) bool {
+	for _, issue := range result.Issues {
+		if issue.Check == check {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBulkDoctor(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	remotes := filepath.Join(tmpDir, "remotes")
+
+	// healthy: pushed with upstream
+	initTagRepo(t, filepath.Join(workDir, "healthy"), filepath.Join(remotes, "healthy.git"))
+	gitOutput(t, filepath.Join(workDir, "healthy"), "push", "-q", "-u", "origin", "HEAD")
+
+	// noupstream: pushed without -u, so the remote branch exists but is not the upstream
+	initTagRepo(t, filepath.Join(workDir, "noupstream"), filepath.Join(remotes, "noupstream.git"))
+	gitOutput(t, filepath.Join(workDir, "noupstream"), "push", "-q", "origin", "HEAD")
+
+	// detached: HEAD not on a branch
+	initTagRepo(t, filepath.Join(workDir, "detached"), filepath.Join(remotes, "detached.git"))
+	gitOutput(t, filepath.Join(workDir, "detached"), "checkout", "-q", "--detach")
+
+	// local: no remote at all
+	initTagRepo(t, filepath.Join(workDir, "local"), "")
+
+	client := NewClient()
+	ctx := context.Background()
+
+	result, err := client.BulkDoctor(ctx, BulkDoctorOptions{Directory: workDir})
+	if err != nil {
+		t.Fatalf("BulkDoctor failed: %v", err)
+	}
+	if result.TotalProcessed != 4 {
+		t.Fatalf("expected 4 repositories, got %d", result.TotalProcessed)
+	}
+
+	byPath := map[string]RepositoryDoctorResult{}
+	for _, repo := range result.Repositories {
+		byPath[repo.RelativePath] = repo
+	}
+
+	if repo := byPath["healthy"]; repo.Status != StatusHealthy {
+		t.Errorf("healthy: status = %s (%s), want %s", repo.Status, repo.Message, StatusHealthy)
+	}
+	if repo := byPath["noupstream"]; repo.Status != StatusUnhealthy || !hasIssue(repo, HealthMissingUpstream) {
+		t.Errorf("noupstream: unexpected result %s (%s)", repo.Status, repo.Message)
+	} else if !repo.Issues[0].Fixable {
+		t.Error("noupstream: missing upstream should be fixable")
+	}
+	if repo := byPath["detached"]; repo.Status != StatusUnhealthy || !hasIssue(repo, HealthDetachedHead) || repo.Branch != "" {
+		t.Errorf("detached: unexpected result %s (%s)", repo.Status, repo.Message)
+	}
+	if repo := byPath["local"]; repo.Status != StatusUnhealthy || !hasIssue(repo, HealthNoRemote) {
+		t.Errorf("local: unexpected result %s (%s)", repo.Status, repo.Message)
+	}
+	if result.Issues[HealthNoRemote] != 1 || result.Issues[HealthDetachedHead] != 1 {
+		t.Errorf("unexpected issue counts: %v", result.Issues)
+	}
+
+	// Fix sets the upstream to the remote branch of the same name
+	result, err = client.BulkDoctor(ctx, BulkDoctorOptions{
+		Directory:        workDir,
+		Fix:              true,
+		OnlyRepositories: []string{filepath.Join(workDir, "noupstream")},
+	})
+	if err != nil {
+		t.Fatalf("BulkDoctor --fix failed: %v", err)
+	}
+	if len(result.Repositories) != 1 || result.Repositories[0].Status != StatusFixed {
+		t.Fatalf("expected noupstream to be fixed, got %+v", result.Repositories)
+	}
+	if result.Fixed == 0 {
+		t.Error("expected fixed count to be reported")
+	}
+	branch := gitOutput(t, filepath.Join(workDir, "noupstream"), "branch", "--show-current")
+	if got := gitOutput(t, filepath.Join(workDir, "noupstream"), "rev-parse", "--abbrev-ref", "@{upstream}"); got != "origin/"+branch {
+		t.Errorf("upstream = %s, want origin/%s", got, branch)
+	}
+}
+
+func TestBulkDoctorDiverged(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "work", "repo")
+
+	initTagRepo(t, repoPath, filepath.Join(tmpDir, "repo.git"))
+	gitOutput(t, repoPath, "push", "-q", "-u", "origin", "HEAD")
+
+	// A commit only on the upstream, then one only locally
+	gitOutput(t, repoPath, "commit", "-q", "--allow-empty", "-m", "upstream")
+	gitOutput(t, repoPath, "push", "-q")
+	gitOutput(t, repoPath, "reset", "-q", "--hard", "HEAD~1")
+	gitOutput(t, repoPath, "commit", "-q", "--allow-empty", "-m", "local")
+
+	result, err := NewClient().BulkDoctor(context.Background(), BulkDoctorOptions{Directory: filepath.Join(tmpDir, "work")})
+	if err != nil {
+		t.Fatalf("BulkDoctor failed: %v", err)
+	}
+	if len(result.Repositories) != 1 || !hasIssue(result.Repositories[0], HealthDiverged) {
+		t.Fatalf("expected a diverged issue, got %+v", result.Repositories)
+	}
+}
+
+func TestBulkDoctorInvalidRemote(t *testing.T) {
+	_, err := NewClient().BulkDoctor(context.Background(), BulkDoctorOptions{Directory: t.TempDir(), Remote: "--upload-pack=x"})
+	if err == nil {
+		t.Fatal("expected error for invalid remote name")
+	}
+}
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+)
+
+// BulkExec scans for repositories and runs a git command in each of them in parallel
+func (c *client) BulkExec(ctx context.Context, opts BulkExecOptions) (*BulkExecResult, error) {
+	startTime := time.Now()
+
+	// Validate the command before scanning, so unsafe input fails fast
+	args, err := validateExecArgs(opts.Args)
+	if err != nil {
+		return nil, err
+	}
+	opts.Args = args
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkExecResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryExecResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Args:           opts.Args,
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processExecRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkExecResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateExecSummary(results),
+		Args:           opts.Args,
+	}, nil
+}
+
+// validateExecArgs checks that args name a git subcommand and pass sanitization.
+// Global options before the subcommand (-c, -C, --git-dir, ...) are rejected
+// because they can change which repository or configuration the command uses.
+func validateExecArgs(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, &ValidationError{
+			Field:  "args",
+			Value:  "",
+			Reason: "git command is required",
+		}
+	}
+
+	if strings.HasPrefix(args[0], "-") {
+		return nil, &ValidationError{
+			Field:  "args",
+			Value:  args[0],
+			Reason: "command must start with a git subcommand, not an option",
+		}
+	}
+
+	sanitized, err := gitcmd.SanitizeArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("unsafe git command: %w", err)
+	}
+
+	return sanitized, nil
+}
+
+// processExecRepositories runs the command in repositories in parallel
+func (c *client) processExecRepositories(ctx context.Context, rootDir string, repos []string, opts BulkExecOptions, logger Logger) ([]RepositoryExecResult, error) {
+	results := make([]RepositoryExecResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// Create error group with concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+
+		g.Go(func() error {
+			// Call progress callback
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(repos), repoPath)
+			}
+			events.Started(i+1, repoPath)
+
+			result := c.processExecRepository(gctx, rootDir, repoPath, opts, logger)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// processExecRepository runs the command in a single repository
+func (c *client) processExecRepository(ctx context.Context, rootDir, repoPath string, opts BulkExecOptions, logger Logger) RepositoryExecResult {
+	startTime := time.Now()
+	command := "git " + strings.Join(opts.Args, " ")
+
+	result := RepositoryExecResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+		ExitCode:     -1,
+	}
+
+	// Dry run - don't actually run the command
+	if opts.DryRun {
+		result.Status = StatusWouldRun
+		result.Message = fmt.Sprintf("Would run '%s'", command)
+		result.ExitCode = 0
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	execResult, err := c.executor.Run(ctx, repoPath, opts.Args...)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to run command"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		logger.Error("exec failed", "path", result.RelativePath, "error", err)
+		return result
+	}
+
+	result.ExitCode = execResult.ExitCode
+	result.Stdout = execResult.Stdout
+	result.Stderr = execResult.Stderr
+
+	if execResult.ExitCode != 0 {
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("Exited with code %d", execResult.ExitCode)
+		result.Error = &gitcmd.GitError{
+			Command:  command,
+			ExitCode: execResult.ExitCode,
+			Stderr:   execResult.Stderr,
+		}
+		result.Duration = time.Since(startTime)
+		logger.Warn("command failed", "path", result.RelativePath, "exit", execResult.ExitCode)
+		return result
+	}
+
+	result.Status = StatusSuccess
+	result.Message = "Command succeeded"
+	result.Duration = time.Since(startTime)
+
+	logger.Info("command succeeded", "path", result.RelativePath)
+
+	return result
+}
+
+// calculateExecSummary creates a summary of exec results by status
+func calculateExecSummary(results []RepositoryExecResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
+
+// ExecOutputGroup is a set of repositories that produced identical command output.
+type ExecOutputGroup struct {
+	// Status is the shared status of the repositories
+	Status string
+
+	// ExitCode is the shared exit code
+	ExitCode int
+
+	// Stdout is the shared standard output
+	Stdout string
+
+	// Stderr is the shared standard error output
+	Stderr string
+
+	// Repositories are the relative paths of the repositories, in result order
+	Repositories []string
+}
+
+// GroupOutputs collapses repositories with identical status, exit code and output
+// into groups, so a command that prints the same thing everywhere is shown once.
+// Groups are ordered by their first repository in the results.
+func (r *BulkExecResult) GroupOutputs() []ExecOutputGroup {
+	type groupKey struct {
+		status   string
+		exitCode int
+		stdout   string
+		stderr   string
+	}
+
+	var groups []ExecOutputGroup
+	index := make(map[groupKey]int)
+
+	for _, repo := range r.Repositories {
+		key := groupKey{repo.Status, repo.ExitCode, repo.Stdout, repo.Stderr}
+		if repo.Error != nil && repo.Stderr == "" {
+			// Errors without git output (e.g. sanitization) are grouped by message
+			key.stderr = repo.Error.Error()
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, ExecOutputGroup{
+				Status:   key.status,
+				ExitCode: key.exitCode,
+				Stdout:   key.stdout,
+				Stderr:   key.stderr,
+			})
+		}
+		groups[i].Repositories = append(groups[i].Repositories, repo.RelativePath)
+	}
+
+	return groups
+}
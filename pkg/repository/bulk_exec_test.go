@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBulkExec(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	for _, name := range []string{"repo1", "repo2", "repo3"} {
+		repoPath := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(repoPath, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		if err := initGitRepoWithCommit(repoPath); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	// Give repo3 a different current branch
+	if err := initExecBranch(filepath.Join(tmpDir, "repo3"), "feature"); err != nil {
+		t.Fatalf("Failed to create branch: %v", err)
+	}
+
+	client := NewClient()
+	ctx := context.Background()
+
+	result, err := client.BulkExec(ctx, BulkExecOptions{
+		Directory: tmpDir,
+		Args:      []string{"branch", "--show-current"},
+	})
+	if err != nil {
+		t.Fatalf("BulkExec failed: %v", err)
+	}
+
+	if result.TotalProcessed != 3 || result.Summary[StatusSuccess] != 3 {
+		t.Fatalf("expected 3 successful results, got %v", result.Summary)
+	}
+
+	groups := result.GroupOutputs()
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 output groups, got %+v", groups)
+	}
+	if !reflect.DeepEqual(groups[0].Repositories, []string{"repo1", "repo2"}) {
+		t.Errorf("expected repo1 and repo2 to share output, got %v", groups[0].Repositories)
+	}
+	if strings.TrimSpace(groups[1].Stdout) != "feature" {
+		t.Errorf("expected feature branch output, got %q", groups[1].Stdout)
+	}
+
+	// Failing commands are reported per repository, not as an operation error
+	result, err = client.BulkExec(ctx, BulkExecOptions{
+		Directory: tmpDir,
+		Args:      []string{"rev-parse", "--verify", "refs/heads/missing"},
+	})
+	if err != nil {
+		t.Fatalf("BulkExec failed: %v", err)
+	}
+	if result.Summary[StatusError] != 3 {
+		t.Errorf("expected 3 errors, got %v", result.Summary)
+	}
+	if result.Repositories[0].ExitCode == 0 || result.Repositories[0].Error == nil {
+		t.Errorf("expected non-zero exit code and error, got %+v", result.Repositories[0])
+	}
+
+	// Dry run does not run the command
+	result, err = client.BulkExec(ctx, BulkExecOptions{
+		Directory: tmpDir,
+		Args:      []string{"status"},
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatalf("BulkExec dry-run failed: %v", err)
+	}
+	if result.Summary[StatusWouldRun] != 3 {
+		t.Errorf("expected 3 would-run results, got %v", result.Summary)
+	}
+}
+
+// initExecBranch creates and checks out a branch in an existing repository
+func initExecBranch(path, branch string) error {
+	cmd := exec.Command("git", "checkout", "-b", branch)
+	cmd.Dir = path
+	return cmd.Run()
+}
+
+func TestBulkExecRejectsUnsafeArgs(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"empty", nil},
+		{"global option", []string{"-c", "core.pager=less", "log"}},
+		{"git dir", []string{"--git-dir=/tmp/x", "status"}},
+		{"command injection", []string{"log", "; rm -rf ~"}},
+		{"unknown flag", []string{"log", "--output=/tmp/x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Validation happens before scanning, so the directory is not touched
+			if _, err := client.BulkExec(ctx, BulkExecOptions{Directory: t.TempDir(), Args: tt.args}); err == nil {
+				t.Errorf("expected error for %v", tt.args)
+			}
+		})
+	}
+}
+
+func TestBulkExecGroupOutputs(t *testing.T) {
+	result := &BulkExecResult{
+		Repositories: []RepositoryExecResult{
+			{RelativePath: "a", Status: StatusSuccess, Stdout: "main\n"},
+			{RelativePath: "b", Status: StatusError, ExitCode: -1, Error: errors.New("timeout")},
+			{RelativePath: "c", Status: StatusSuccess, Stdout: "main\n"},
+			{RelativePath: "d", Status: StatusSuccess, Stdout: "develop\n"},
+		},
+	}
+
+	groups := result.GroupOutputs()
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %+v", groups)
+	}
+
+	want := [][]string{{"a", "c"}, {"b"}, {"d"}}
+	for i, group := range groups {
+		if !reflect.DeepEqual(group.Repositories, want[i]) {
+			t.Errorf("group %d = %v, want %v", i, group.Repositories, want[i])
+		}
+	}
+	if groups[1].Stderr != "timeout" {
+		t.Errorf("expected error message in group output, got %q", groups[1].Stderr)
+	}
+}
@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultIncomingMaxCommits is the default number of incoming commits listed per repository.
+const DefaultIncomingMaxCommits = 20
+
+// IncomingCommit is an upstream commit not yet in the local branch.
+type IncomingCommit struct {
+	// Hash is the full commit hash
+	Hash string
+
+	// Author is the author name
+	Author string
+
+	// Date is the author date
+	Date time.Time
+
+	// Subject is the first line of the commit message
+	Subject string
+
+	// Files are the paths the commit touched (empty for merge commits)
+	Files []string
+}
+
+// Separators in the incoming log format (%x1e and %x1f)
+const (
+	incomingCommitSeparator = "\x1e"
+	incomingFieldSeparator  = "\x1f"
+)
+
+// BulkIncoming scans for repositories and lists, for each, the commits on the
+// upstream of the current branch that are not yet in the local branch.
+//
+// Only remote-tracking branches are read, so the result is as current as the
+// last fetch; nothing is changed and the network is not used.
+func (c *client) BulkIncoming(ctx context.Context, opts BulkIncomingOptions) (*BulkIncomingResult, error) {
+	startTime := time.Now()
+
+	// Apply defaults
+	if opts.MaxCommits <= 0 {
+		opts.MaxCommits = DefaultIncomingMaxCommits
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkIncomingResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryIncomingResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processIncomingRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	result := &BulkIncomingResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateIncomingSummary(results),
+	}
+	for _, repo := range results {
+		result.TotalCommits += repo.CommitsBehind
+	}
+
+	return result, nil
+}
+
+// processIncomingRepositories lists the incoming commits of multiple repositories in parallel
+func (c *client) processIncomingRepositories(ctx context.Context, rootDir string, repos []string, opts BulkIncomingOptions, logger Logger) ([]RepositoryIncomingResult, error) {
+	results := make([]RepositoryIncomingResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// Create error group with concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+
+		g.Go(func() error {
+			// Call progress callback
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(repos), repoPath)
+			}
+			events.Started(i+1, repoPath)
+
+			result := c.processIncomingRepository(gctx, rootDir, repoPath, opts, logger)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// processIncomingRepository lists the incoming commits of a single repository
+func (c *client) processIncomingRepository(ctx context.Context, rootDir, repoPath string, opts BulkIncomingOptions, logger Logger) RepositoryIncomingResult {
+	startTime := time.Now()
+
+	result := RepositoryIncomingResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	c.listIncomingCommits(ctx, repoPath, opts, logger, &result)
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// listIncomingCommits compares the current branch with its upstream and reads the incoming commits
+func (c *client) listIncomingCommits(ctx context.Context, repoPath string, opts BulkIncomingOptions, logger Logger, result *RepositoryIncomingResult) {
+	branch, err := c.executor.RunOutput(ctx, repoPath, "branch", "--show-current")
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to get current branch"
+		result.Error = err
+		return
+	}
+	if branch == "" {
+		result.Status = StatusSkipped
+		result.Message = "HEAD is detached"
+		return
+	}
+	result.Branch = branch
+
+	upstream, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--abbrev-ref", "@{upstream}")
+	if err != nil || upstream == "" {
+		result.Status = StatusNoUpstream
+		result.Message = fmt.Sprintf("Branch '%s' has no upstream", branch)
+		return
+	}
+	result.Upstream = upstream
+
+	counts, err := c.executor.RunOutput(ctx, repoPath, "rev-list", "--left-right", "--count", "HEAD...@{upstream}")
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to compare with upstream"
+		result.Error = err
+		return
+	}
+	ahead, behind, err := parseAheadBehind(counts)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to compare with upstream"
+		result.Error = err
+		return
+	}
+	result.CommitsAhead = ahead
+	result.CommitsBehind = behind
+
+	if behind == 0 {
+		result.Status = StatusUpToDate
+		result.Message = fmt.Sprintf("Up to date with %s", upstream)
+		return
+	}
+
+	output, err := c.executor.RunOutput(ctx, repoPath, "log",
+		"--format=%x1e%H%x1f%an%x1f%aI%x1f%s",
+		"--name-only", fmt.Sprintf("--max-count=%d", opts.MaxCommits), "HEAD..@{upstream}", "--")
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to list incoming commits"
+		result.Error = err
+		logger.Error("listing incoming commits failed", "path", result.RelativePath, "error", err)
+		return
+	}
+	result.Commits = parseIncomingLog(output)
+
+	result.Status = StatusIncoming
+	result.Message = fmt.Sprintf("%d new %s on %s", behind, PluralSuffix(behind, "commit", "commits"), upstream)
+	if ahead > 0 {
+		result.Message += fmt.Sprintf(" (%d local %s not pushed)", ahead, PluralSuffix(ahead, "commit", "commits"))
+	}
+}
+
+// parseIncomingLog parses the log output of listIncomingCommits: one record per
+// commit, starting with the separated fields and followed by the touched files
+func parseIncomingLog(output string) []IncomingCommit {
+	var commits []IncomingCommit
+
+	for _, record := range strings.Split(output, incomingCommitSeparator) {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.SplitN(lines[0], incomingFieldSeparator, 4)
+		if len(fields) < 4 {
+			continue
+		}
+
+		commit := IncomingCommit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Subject: fields[3],
+			Files:   []string{},
+		}
+		if date, err := time.Parse(time.RFC3339, fields[2]); err == nil {
+			commit.Date = date
+		}
+		for _, file := range lines[1:] {
+			if file = strings.TrimSpace(file); file != "" {
+				commit.Files = append(commit.Files, file)
+			}
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits
+}
+
+// calculateIncomingSummary creates a summary of incoming commit results by status
+func calculateIncomingSummary(results []RepositoryIncomingResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBulkIncoming(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	upstreamDir := filepath.Join(tmpDir, "upstream")
+
+	// behind: two commits pushed from another clone
+	initTagRepo(t, filepath.Join(workDir, "behind"), filepath.Join(tmpDir, "behind.git"))
+	gitOutput(t, filepath.Join(workDir, "behind"), "push", "-q", "-u", "origin", "HEAD")
+	gitOutput(t, tmpDir, "clone", "-q", filepath.Join(tmpDir, "behind.git"), upstreamDir)
+	gitOutput(t, upstreamDir, "config", "user.name", "Alice")
+	gitOutput(t, upstreamDir, "config", "user.email", "alice@example.com")
+	if err := os.WriteFile(filepath.Join(upstreamDir, "api.go"), []byte("package api\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	gitOutput(t, upstreamDir, "add", "api.go")
+	gitOutput(t, upstreamDir, "commit", "-q", "-m", "Add API")
+	gitOutput(t, upstreamDir, "commit", "-q", "--allow-empty", "-m", "Bump version")
+	gitOutput(t, upstreamDir, "push", "-q")
+	gitOutput(t, filepath.Join(workDir, "behind"), "fetch", "-q")
+
+	// current: up to date with its upstream
+	initTagRepo(t, filepath.Join(workDir, "current"), filepath.Join(tmpDir, "current.git"))
+	gitOutput(t, filepath.Join(workDir, "current"), "push", "-q", "-u", "origin", "HEAD")
+
+	// local: no upstream
+	initTagRepo(t, filepath.Join(workDir, "local"), "")
+
+	result, err := NewClient().BulkIncoming(context.Background(), BulkIncomingOptions{Directory: workDir})
+	if err != nil {
+		t.Fatalf("BulkIncoming failed: %v", err)
+	}
+	if result.Summary[StatusIncoming] != 1 || result.Summary[StatusUpToDate] != 1 || result.Summary[StatusNoUpstream] != 1 {
+		t.Fatalf("unexpected summary: %v", result.Summary)
+	}
+	if result.TotalCommits != 2 {
+		t.Errorf("TotalCommits = %d, want 2", result.TotalCommits)
+	}
+
+	for _, repo := range result.Repositories {
+		if repo.RelativePath != "behind" {
+			continue
+		}
+		if repo.CommitsBehind != 2 || len(repo.Commits) != 2 {
+			t.Fatalf("behind: got %d behind, %d commits", repo.CommitsBehind, len(repo.Commits))
+		}
+		// Newest first
+		if repo.Commits[0].Subject != "Bump version" || repo.Commits[1].Subject != "Add API" {
+			t.Errorf("unexpected subjects: %q, %q", repo.Commits[0].Subject, repo.Commits[1].Subject)
+		}
+		added := repo.Commits[1]
+		if added.Author != "Alice" || added.Date.IsZero() || len(added.Files) != 1 || added.Files[0] != "api.go" {
+			t.Errorf("unexpected commit: %+v", added)
+		}
+		if len(repo.Commits[0].Files) != 0 {
+			t.Errorf("empty commit lists files: %v", repo.Commits[0].Files)
+		}
+	}
+
+	// MaxCommits limits the list but not the count
+	result, err = NewClient().BulkIncoming(context.Background(), BulkIncomingOptions{
+		Directory:        workDir,
+		MaxCommits:       1,
+		OnlyRepositories: []string{filepath.Join(workDir, "behind")},
+	})
+	if err != nil {
+		t.Fatalf("BulkIncoming failed: %v", err)
+	}
+	if repo := result.Repositories[0]; repo.CommitsBehind != 2 || len(repo.Commits) != 1 {
+		t.Errorf("with MaxCommits 1: got %d behind, %d commits", repo.CommitsBehind, len(repo.Commits))
+	}
+}
+
+func TestParseIncomingLog(t *testing.T) {
+	output := "\x1eaaa\x1fAlice\x1f2024-05-01T10:00:00+02:00\x1fFix: a\x1fb\n\nsrc/a.go\nsrc/b.go\n\x1ebbb\x1fBob\x1finvalid\x1fMerge branch 'x'"
+	commits := parseIncomingLog(output)
+	if len(commits) != 2 {
+		t.Fatalf("got %d commits, want 2", len(commits))
+	}
+	if commits[0].Subject != "Fix: a\x1fb" || len(commits[0].Files) != 2 || commits[0].Date.IsZero() {
+		t.Errorf("unexpected first commit: %+v", commits[0])
+	}
+	if commits[1].Author != "Bob" || !commits[1].Date.IsZero() || len(commits[1].Files) != 0 {
+		t.Errorf("unexpected second commit: %+v", commits[1])
+	}
+}
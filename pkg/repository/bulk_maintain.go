@@ -0,0 +1,377 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// MaintenanceTask is a maintenance step run by BulkMaintain.
+type MaintenanceTask string
+
+const (
+	// MaintenanceGC runs "git gc": packs loose objects into a single pack, prunes
+	// expired unreachable objects, packs refs and expires reflogs
+	MaintenanceGC MaintenanceTask = "gc"
+
+	// MaintenancePrune removes unreachable loose objects older than PruneExpire
+	MaintenancePrune MaintenanceTask = "prune"
+
+	// MaintenanceRepack combines all packs into one and deletes the redundant packs
+	MaintenanceRepack MaintenanceTask = "repack"
+
+	// MaintenanceCommitGraph writes the commit-graph file, which speeds up log and merge-base
+	MaintenanceCommitGraph MaintenanceTask = "commit-graph"
+)
+
+// DefaultMaintenanceTasks are the tasks BulkMaintain runs when none are given.
+var DefaultMaintenanceTasks = []MaintenanceTask{MaintenanceGC, MaintenanceCommitGraph}
+
+// DefaultPruneExpire is the default minimum age of objects removed by MaintenancePrune,
+// the same as the gc.pruneExpire default.
+const DefaultPruneExpire = "2.weeks.ago"
+
+// ParseMaintenanceTasks converts task names (gc, prune, repack, commit-graph) to tasks.
+func ParseMaintenanceTasks(names []string) ([]MaintenanceTask, error) {
+	tasks := make([]MaintenanceTask, 0, len(names))
+	for _, name := range names {
+		task := MaintenanceTask(strings.TrimSpace(name))
+		if err := validateMaintenanceTask(task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// validateMaintenanceTask checks that task is a known maintenance task
+func validateMaintenanceTask(task MaintenanceTask) error {
+	switch task {
+	case MaintenanceGC, MaintenancePrune, MaintenanceRepack, MaintenanceCommitGraph:
+		return nil
+	default:
+		return fmt.Errorf("invalid maintenance task %q (must be one of: gc, prune, repack, commit-graph)", task)
+	}
+}
+
+// GitDirStats describes the disk usage of a Git directory.
+type GitDirStats struct {
+	// Size is the total size of the Git directory in bytes
+	Size int64
+
+	// LooseObjects is the number of loose objects
+	LooseObjects int
+
+	// LooseSize is the disk space used by loose objects in bytes
+	LooseSize int64
+
+	// Packs is the number of pack files
+	Packs int
+
+	// PackSize is the disk space used by packs in bytes
+	PackSize int64
+}
+
+// BulkMaintain scans for repositories and runs maintenance tasks in each,
+// measuring the Git directory before and after.
+//
+// Tasks run in the given order; a failing task stops the remaining tasks for
+// that repository. Maintenance is disk and CPU intensive, so a lower Parallel
+// than for network operations is usually faster overall.
+func (c *client) BulkMaintain(ctx context.Context, opts BulkMaintainOptions) (*BulkMaintainResult, error) {
+	startTime := time.Now()
+
+	// Apply defaults
+	if len(opts.Tasks) == 0 {
+		opts.Tasks = DefaultMaintenanceTasks
+	}
+	for _, task := range opts.Tasks {
+		if err := validateMaintenanceTask(task); err != nil {
+			return nil, err
+		}
+	}
+	if opts.PruneExpire == "" {
+		opts.PruneExpire = DefaultPruneExpire
+	}
+	if strings.HasPrefix(opts.PruneExpire, "-") || strings.ContainsAny(opts.PruneExpire, " \t") {
+		return nil, &ValidationError{Field: "prune-expire", Value: opts.PruneExpire, Reason: "invalid expiry date"}
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkMaintainResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryMaintainResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Tasks:          opts.Tasks,
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processMaintainRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	result := &BulkMaintainResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateMaintainSummary(results),
+		Tasks:          opts.Tasks,
+	}
+	for _, repo := range results {
+		result.SizeBefore += repo.Before.Size
+		result.SizeAfter += repo.After.Size
+	}
+
+	return result, nil
+}
+
+// Reclaimed returns the disk space freed by maintenance in bytes
+// (negative if the Git directories grew, e.g. by a new commit-graph).
+func (r *BulkMaintainResult) Reclaimed() int64 {
+	return r.SizeBefore - r.SizeAfter
+}
+
+// maintenanceTaskNames returns the names of tasks
+func maintenanceTaskNames(tasks []MaintenanceTask) []string {
+	names := make([]string, len(tasks))
+	for i, task := range tasks {
+		names[i] = string(task)
+	}
+	return names
+}
+
+// processMaintainRepositories runs maintenance in multiple repositories in parallel
+func (c *client) processMaintainRepositories(ctx context.Context, rootDir string, repos []string, opts BulkMaintainOptions, logger Logger) ([]RepositoryMaintainResult, error) {
+	results := make([]RepositoryMaintainResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// Create error group with concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+
+		g.Go(func() error {
+			// Call progress callback
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(repos), repoPath)
+			}
+			events.Started(i+1, repoPath)
+
+			result := c.processMaintainRepository(gctx, rootDir, repoPath, opts, logger)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// processMaintainRepository runs the maintenance tasks in a single repository
+func (c *client) processMaintainRepository(ctx context.Context, rootDir, repoPath string, opts BulkMaintainOptions, logger Logger) RepositoryMaintainResult {
+	startTime := time.Now()
+
+	result := RepositoryMaintainResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	before, err := c.gitDirStats(ctx, repoPath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to measure Git directory"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+	result.Before = before
+	result.After = before
+
+	if opts.DryRun {
+		result.Status = StatusWouldMaintain
+		result.Message = "Would run " + strings.Join(maintenanceTaskNames(opts.Tasks), ", ")
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	for _, task := range opts.Tasks {
+		if err := c.runMaintenanceTask(ctx, repoPath, task, opts); err != nil {
+			result.Status = StatusError
+			result.Message = fmt.Sprintf("%s failed", task)
+			result.Error = err
+			logger.Error("maintenance task failed", "path", result.RelativePath, "task", task, "error", err)
+			break
+		}
+		result.Tasks = append(result.Tasks, task)
+	}
+
+	// Measure again even after a failure, as earlier tasks may have freed space
+	if after, err := c.gitDirStats(ctx, repoPath); err == nil {
+		result.After = after
+	}
+
+	if result.Status == "" {
+		result.Status = StatusMaintained
+		result.Message = "Ran " + strings.Join(maintenanceTaskNames(result.Tasks), ", ")
+		logger.Info("repository maintained", "path", result.RelativePath, "before", result.Before.Size, "after", result.After.Size)
+	}
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// runMaintenanceTask runs a single maintenance task
+func (c *client) runMaintenanceTask(ctx context.Context, repoPath string, task MaintenanceTask, opts BulkMaintainOptions) error {
+	var args []string
+	switch task {
+	case MaintenanceGC:
+		args = []string{"gc", "--quiet"}
+		if opts.Aggressive {
+			args = append(args, "--aggressive")
+		}
+	case MaintenancePrune:
+		args = []string{"prune", "--expire=" + opts.PruneExpire}
+	case MaintenanceRepack:
+		args = []string{"repack", "-a", "-d", "-q"}
+	case MaintenanceCommitGraph:
+		args = []string{"commit-graph", "write", "--reachable"}
+	default:
+		return validateMaintenanceTask(task)
+	}
+
+	taskResult, err := c.executor.Run(ctx, repoPath, args...)
+	if err != nil {
+		return err
+	}
+	if taskResult.ExitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", args[0], taskResult.ExitCode, strings.TrimSpace(taskResult.Stderr))
+	}
+	return nil
+}
+
+// gitDirStats measures the Git directory of a repository. For linked
+// worktrees, the shared Git directory holding the objects is measured.
+func (c *client) gitDirStats(ctx context.Context, repoPath string) (GitDirStats, error) {
+	var stats GitDirStats
+
+	gitDir, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--git-common-dir")
+	if err != nil {
+		return stats, fmt.Errorf("failed to locate Git directory: %w", err)
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(repoPath, gitDir)
+	}
+
+	err = filepath.WalkDir(gitDir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // Files removed by a concurrent git process
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				stats.Size += info.Size()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, fmt.Errorf("failed to measure Git directory: %w", err)
+	}
+
+	lines, err := c.executor.RunLines(ctx, repoPath, "count-objects", "-v")
+	if err != nil {
+		return stats, fmt.Errorf("failed to count objects: %w", err)
+	}
+	parseCountObjects(lines, &stats)
+
+	return stats, nil
+}
+
+// parseCountObjects reads the output of "git count-objects -v", where sizes are in KiB
+func parseCountObjects(lines []string, stats *GitDirStats) {
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "count":
+			stats.LooseObjects = int(n)
+		case "size":
+			stats.LooseSize = n * 1024
+		case "packs":
+			stats.Packs = int(n)
+		case "size-pack":
+			stats.PackSize = n * 1024
+		}
+	}
+}
+
+// calculateMaintainSummary creates a summary of maintenance results by status
+func calculateMaintainSummary(results []RepositoryMaintainResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
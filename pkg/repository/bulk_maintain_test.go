@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseMaintenanceTasks(t *testing.T) {
+	tasks, err := ParseMaintenanceTasks([]string{"gc", " prune", "repack", "commit-graph"})
+	if err != nil {
+		t.Fatalf("ParseMaintenanceTasks failed: %v", err)
+	}
+	want := []MaintenanceTask{MaintenanceGC, MaintenancePrune, MaintenanceRepack, MaintenanceCommitGraph}
+	if len(tasks) != len(want) {
+		t.Fatalf("got %v, want %v", tasks, want)
+	}
+	for i := range want {
+		if tasks[i] != want[i] {
+			t.Errorf("task %d = %s, want %s", i, tasks[i], want[i])
+		}
+	}
+
+	if _, err := ParseMaintenanceTasks([]string{"gc", "fsck"}); err == nil {
+		t.Error("expected an error for an unknown task")
+	}
+}
+
+func TestParseCountObjects(t *testing.T) {
+	var stats GitDirStats
+	parseCountObjects([]string{
+		"count: 12",
+		"size: 48",
+		"in-pack: 300",
+		"packs: 3",
+		"size-pack: 1024",
+		"prune-packable: 0",
+		"garbage: 0",
+		"size-garbage: 0",
+	}, &stats)
+
+	want := GitDirStats{LooseObjects: 12, LooseSize: 48 * 1024, Packs: 3, PackSize: 1024 * 1024}
+	if stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+}
+
+func TestBulkMaintain(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	if err := os.MkdirAll(repoPath, 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := initGitRepoWithCommit(repoPath); err != nil {
+		t.Skipf("Skipping test: git not available: %v", err)
+	}
+
+	client := NewClient()
+	ctx := context.Background()
+
+	// Dry run only measures
+	result, err := client.BulkMaintain(ctx, BulkMaintainOptions{Directory: tmpDir, DryRun: true})
+	if err != nil {
+		t.Fatalf("BulkMaintain dry-run failed: %v", err)
+	}
+	if result.Summary[StatusWouldMaintain] != 1 {
+		t.Fatalf("expected 1 would-maintain result, got %v", result.Summary)
+	}
+	repo := result.Repositories[0]
+	if repo.Before.Size == 0 || repo.Before.LooseObjects == 0 || repo.After != repo.Before {
+		t.Errorf("unexpected dry-run stats: before %+v, after %+v", repo.Before, repo.After)
+	}
+
+	// The default tasks pack the loose objects and write a commit-graph
+	result, err = client.BulkMaintain(ctx, BulkMaintainOptions{Directory: tmpDir})
+	if err != nil {
+		t.Fatalf("BulkMaintain failed: %v", err)
+	}
+	repo = result.Repositories[0]
+	if repo.Status != StatusMaintained {
+		t.Fatalf("status = %s (%s: %v), want %s", repo.Status, repo.Message, repo.Error, StatusMaintained)
+	}
+	if repo.After.LooseObjects != 0 || repo.After.Packs != 1 {
+		t.Errorf("after gc: %d loose objects, %d packs; want 0 and 1", repo.After.LooseObjects, repo.After.Packs)
+	}
+	if len(repo.Tasks) != len(DefaultMaintenanceTasks) {
+		t.Errorf("ran tasks %v, want %v", repo.Tasks, DefaultMaintenanceTasks)
+	}
+	if _, err := os.Stat(filepath.Join(repoPath, ".git", "objects", "info", "commit-graph")); err != nil {
+		t.Errorf("commit-graph not written: %v", err)
+	}
+	if result.SizeBefore != repo.Before.Size || result.SizeAfter != repo.After.Size {
+		t.Errorf("totals %d → %d do not match the repository", result.SizeBefore, result.SizeAfter)
+	}
+
+	// Prune and repack run with explicit tasks
+	result, err = client.BulkMaintain(ctx, BulkMaintainOptions{
+		Directory:   tmpDir,
+		Tasks:       []MaintenanceTask{MaintenancePrune, MaintenanceRepack},
+		PruneExpire: "now",
+	})
+	if err != nil {
+		t.Fatalf("BulkMaintain prune/repack failed: %v", err)
+	}
+	if result.Summary[StatusMaintained] != 1 {
+		t.Errorf("prune/repack: %s (%v)", result.Repositories[0].Message, result.Repositories[0].Error)
+	}
+}
+
+func TestBulkMaintainValidation(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+
+	if _, err := client.BulkMaintain(ctx, BulkMaintainOptions{Directory: t.TempDir(), Tasks: []MaintenanceTask{"fsck"}}); err == nil {
+		t.Error("expected an error for an unknown task")
+	}
+	if _, err := client.BulkMaintain(ctx, BulkMaintainOptions{Directory: t.TempDir(), PruneExpire: "--all"}); err == nil {
+		t.Error("expected an error for an invalid prune expiry")
+	}
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BulkRemoteSetURL scans for repositories and rewrites a remote URL in each.
+//
+// The part of the current URL matched by From is replaced with To, so
+// From "git@old.example.com:(.*)" and To "git@new.example.com:$1" move every
+// matching clone to the new host. With Verify, the new URL is checked with
+// ls-remote first, and repositories whose new URL is unreachable are not changed.
+func (c *client) BulkRemoteSetURL(ctx context.Context, opts BulkRemoteSetURLOptions) (*BulkRemoteSetURLResult, error) {
+	startTime := time.Now()
+
+	// Validate required options
+	if opts.Remote == "" {
+		opts.Remote = "origin"
+	}
+	if strings.HasPrefix(opts.Remote, "-") || strings.ContainsAny(opts.Remote, " \t") {
+		return nil, &ValidationError{Field: "remote", Value: opts.Remote, Reason: "invalid remote name"}
+	}
+	if opts.From == "" {
+		return nil, &ValidationError{Field: "from", Value: opts.From, Reason: "URL pattern is required"}
+	}
+	pattern, err := regexp.Compile(opts.From)
+	if err != nil {
+		return nil, &ValidationError{Field: "from", Value: opts.From, Reason: fmt.Sprintf("invalid regular expression: %v", err)}
+	}
+	if opts.To == "" {
+		return nil, &ValidationError{Field: "to", Value: opts.To, Reason: "replacement URL is required"}
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkRemoteSetURLResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryRemoteSetURLResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Remote:         opts.Remote,
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processRemoteRepositories(ctx, opts.Directory, filteredRepos, pattern, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkRemoteSetURLResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateRemoteSummary(results),
+		Remote:         opts.Remote,
+	}, nil
+}
+
+// processRemoteRepositories rewrites the remote URL of multiple repositories in parallel
+func (c *client) processRemoteRepositories(ctx context.Context, rootDir string, repos []string, pattern *regexp.Regexp, opts BulkRemoteSetURLOptions, logger Logger) ([]RepositoryRemoteSetURLResult, error) {
+	results := make([]RepositoryRemoteSetURLResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+	hosts := newHostLimiter(opts.HostLimits)
+
+	// Only verification contacts a remote: the host of the new URL
+	var remoteURLs []string
+	if opts.Verify {
+		remoteURLs = c.remoteURLs(ctx, hosts, repos, opts.Remote, opts.Parallel)
+		for i, oldURL := range remoteURLs {
+			if pattern.MatchString(oldURL) {
+				remoteURLs[i] = pattern.ReplaceAllString(oldURL, opts.To)
+			} else {
+				remoteURLs[i] = ""
+			}
+		}
+	}
+
+	// Process repositories; each waits for a slot on its remote host first
+	hosts.run(ctx, len(repos), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		repoPath := repos[i]
+
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repoPath)
+		}
+		events.Started(i+1, repoPath)
+
+		result := c.processRemoteRepository(ctx, rootDir, repoPath, pattern, opts, logger)
+
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, repoPath, result.Status, result.Duration)
+		mu.Unlock()
+	})
+
+	return results, nil
+}
+
+// processRemoteRepository rewrites the remote URL of a single repository
+func (c *client) processRemoteRepository(ctx context.Context, rootDir, repoPath string, pattern *regexp.Regexp, opts BulkRemoteSetURLOptions, logger Logger) RepositoryRemoteSetURLResult {
+	startTime := time.Now()
+
+	result := RepositoryRemoteSetURLResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	c.rewriteRemoteURL(ctx, repoPath, pattern, opts, logger, &result)
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// rewriteRemoteURL matches, verifies and sets the new remote URL
+func (c *client) rewriteRemoteURL(ctx context.Context, repoPath string, pattern *regexp.Regexp, opts BulkRemoteSetURLOptions, logger Logger, result *RepositoryRemoteSetURLResult) {
+	oldURL, err := c.executor.RunOutput(ctx, repoPath, "remote", "get-url", opts.Remote)
+	if err != nil {
+		result.Status = StatusNoRemote
+		result.Message = fmt.Sprintf("Remote '%s' is not configured", opts.Remote)
+		return
+	}
+	result.OldURL = oldURL
+
+	if !pattern.MatchString(oldURL) {
+		result.Status = StatusNoMatch
+		result.Message = "URL does not match"
+		return
+	}
+
+	newURL := pattern.ReplaceAllString(oldURL, opts.To)
+	result.NewURL = newURL
+	if newURL == oldURL {
+		result.Status = StatusUpToDate
+		result.Message = "URL already set"
+		return
+	}
+
+	if opts.Verify {
+		lsResult, err := c.executor.Run(ctx, repoPath, "ls-remote", newURL, "HEAD")
+		if err != nil || lsResult.ExitCode != 0 {
+			result.Status = StatusUnreachable
+			result.Message = fmt.Sprintf("%s is not reachable; URL not changed", newURL)
+			if err != nil {
+				result.Error = err
+			} else {
+				result.Error = fmt.Errorf("ls-remote exited with code %d: %s", lsResult.ExitCode, strings.TrimSpace(lsResult.Stderr))
+			}
+			logger.Warn("new remote URL unreachable", "path", result.RelativePath, "url", newURL, "error", result.Error)
+			return
+		}
+		result.Verified = true
+	}
+
+	if opts.DryRun {
+		result.Status = StatusWouldUpdate
+		result.Message = fmt.Sprintf("Would change %s → %s", oldURL, newURL)
+		return
+	}
+
+	setResult, err := c.executor.Run(ctx, repoPath, "remote", "set-url", opts.Remote, newURL)
+	if err != nil || setResult.ExitCode != 0 {
+		result.Status = StatusError
+		result.Message = "Failed to set remote URL"
+		if err != nil {
+			result.Error = err
+		} else {
+			result.Error = fmt.Errorf("remote set-url exited with code %d: %s", setResult.ExitCode, strings.TrimSpace(setResult.Stderr))
+		}
+		logger.Error("remote set-url failed", "path", result.RelativePath, "error", result.Error)
+		return
+	}
+
+	result.Status = StatusUpdated
+	result.Message = fmt.Sprintf("%s → %s", oldURL, newURL)
+	logger.Info("remote URL changed", "path", result.RelativePath, "remote", opts.Remote, "url", newURL)
+}
+
+// calculateRemoteSummary creates a summary of remote URL rewrite results by status
+func calculateRemoteSummary(results []RepositoryRemoteSetURLResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// remoteURL returns the URL of the origin remote of the repository at path
+func remoteURL(t *testing.T, path string) string {
+	t.Helper()
+	out, err := exec.Command("git", "-C", path, "remote", "get-url", "origin").Output()
+	if err != nil {
+		t.Fatalf("git remote get-url failed: %v", err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestBulkRemoteSetURL(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	oldHost := filepath.Join(tmpDir, "old")
+	newHost := filepath.Join(tmpDir, "new")
+
+	initTagRepo(t, filepath.Join(workDir, "api"), filepath.Join(oldHost, "api.git"))
+	initTagRepo(t, filepath.Join(workDir, "web"), filepath.Join(oldHost, "web.git"))
+	initTagRepo(t, filepath.Join(workDir, "ops"), filepath.Join(tmpDir, "elsewhere", "ops.git"))
+	initTagRepo(t, filepath.Join(workDir, "local"), "")
+
+	// Only api exists on the new host
+	if out, err := exec.Command("git", "init", "--bare", filepath.Join(newHost, "api.git")).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare failed: %v\n%s", err, out)
+	}
+
+	client := NewClient()
+	ctx := context.Background()
+	opts := BulkRemoteSetURLOptions{
+		Directory: workDir,
+		From:      "^" + oldHost + "/(.*)$",
+		To:        newHost + "/$1",
+		DryRun:    true,
+	}
+
+	// Dry run previews without changing anything
+	result, err := client.BulkRemoteSetURL(ctx, opts)
+	if err != nil {
+		t.Fatalf("BulkRemoteSetURL dry-run failed: %v", err)
+	}
+	if result.Summary[StatusWouldUpdate] != 2 || result.Summary[StatusNoMatch] != 1 || result.Summary[StatusNoRemote] != 1 {
+		t.Fatalf("unexpected dry-run summary: %v", result.Summary)
+	}
+	if got := remoteURL(t, filepath.Join(workDir, "api")); got != filepath.Join(oldHost, "api.git") {
+		t.Fatalf("dry run changed the URL to %s", got)
+	}
+
+	// Verified rewrite skips repositories missing on the new host
+	opts.DryRun = false
+	opts.Verify = true
+	result, err = client.BulkRemoteSetURL(ctx, opts)
+	if err != nil {
+		t.Fatalf("BulkRemoteSetURL failed: %v", err)
+	}
+	if result.Summary[StatusUpdated] != 1 || result.Summary[StatusUnreachable] != 1 {
+		t.Fatalf("unexpected summary: %v", result.Summary)
+	}
+	for _, repo := range result.Repositories {
+		switch repo.RelativePath {
+		case "api":
+			if !repo.Verified || repo.OldURL != filepath.Join(oldHost, "api.git") || repo.NewURL != filepath.Join(newHost, "api.git") {
+				t.Errorf("api: unexpected result %+v", repo)
+			}
+		case "web":
+			if repo.Error == nil {
+				t.Error("web: expected an error for the unreachable URL")
+			}
+		}
+	}
+	if got := remoteURL(t, filepath.Join(workDir, "api")); got != filepath.Join(newHost, "api.git") {
+		t.Errorf("api URL = %s, want the new host", got)
+	}
+	if got := remoteURL(t, filepath.Join(workDir, "web")); got != filepath.Join(oldHost, "web.git") {
+		t.Errorf("web URL = %s, want it unchanged", got)
+	}
+}
+
+func TestBulkRemoteSetURLValidation(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+
+	for name, opts := range map[string]BulkRemoteSetURLOptions{
+		"missing from":   {Directory: t.TempDir(), To: "git@new:$1"},
+		"invalid from":   {Directory: t.TempDir(), From: "git@old:(.*", To: "git@new:$1"},
+		"missing to":     {Directory: t.TempDir(), From: "git@old:(.*)"},
+		"invalid remote": {Directory: t.TempDir(), Remote: "--upload-pack", From: "a", To: "b"},
+	} {
+		if _, err := client.BulkRemoteSetURL(ctx, opts); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+}
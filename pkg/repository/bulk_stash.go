@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+)
+
+// BulkStash scans for repositories and saves, lists, pops or drops stash entries in parallel
+func (c *client) BulkStash(ctx context.Context, opts BulkStashOptions) (*BulkStashResult, error) {
+	startTime := time.Now()
+
+	// Validate required options
+	switch opts.Action {
+	case StashSave, StashList, StashPop, StashDrop:
+	case "":
+		return nil, fmt.Errorf("stash action is required")
+	default:
+		return nil, fmt.Errorf("invalid stash action %q (must be one of: save, list, pop, drop)", opts.Action)
+	}
+
+	if opts.Action == StashSave && opts.CreatedBy == "" {
+		opts.CreatedBy = StashCreatorStash
+	}
+	if strings.ContainsAny(opts.CreatedBy, ": ") {
+		return nil, fmt.Errorf("invalid stash creator %q: must not contain ':' or spaces", opts.CreatedBy)
+	}
+
+	// git stash push only takes the message as an argument, which the
+	// argument sanitizer restricts; reject it before touching any repository
+	if opts.Action == StashSave && opts.Message != "" {
+		if _, err := gitcmd.SanitizeArgs([]string{StashMessage(opts.CreatedBy, opts.Message)}); err != nil {
+			return nil, &ValidationError{
+				Field:  "message",
+				Value:  opts.Message,
+				Reason: "stash message must not contain newlines, \"../\" or any of ; & | < > $ `",
+			}
+		}
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkStashResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryStashResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Action:         opts.Action,
+		}, nil
+	}
+
+	// Process repositories in parallel
+	results, err := c.processStashRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkStashResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateStashSummary(results),
+		Action:         opts.Action,
+	}, nil
+}
+
+// processStashRepositories processes repositories in parallel for stash operations
+func (c *client) processStashRepositories(ctx context.Context, rootDir string, repos []string, opts BulkStashOptions, logger Logger) ([]RepositoryStashResult, error) {
+	results := make([]RepositoryStashResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// Create error group with concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+
+		g.Go(func() error {
+			// Call progress callback
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(repos), repoPath)
+			}
+			events.Started(i+1, repoPath)
+
+			result := c.processStashRepository(gctx, rootDir, repoPath, opts, logger)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// processStashRepository processes a stash operation on a single repository
+func (c *client) processStashRepository(ctx context.Context, rootDir, repoPath string, opts BulkStashOptions, logger Logger) RepositoryStashResult {
+	startTime := time.Now()
+
+	result := RepositoryStashResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	// Branch is informational; repositories without commits have none
+	if branch, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		result.Branch = branch
+	}
+
+	switch opts.Action {
+	case StashSave:
+		c.saveStash(ctx, repoPath, opts, logger, &result)
+	case StashList:
+		c.listStashEntries(ctx, repoPath, opts, &result)
+	case StashPop, StashDrop:
+		c.applyStash(ctx, repoPath, opts, logger, &result)
+	}
+
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// saveStash stashes local changes with a labelled message
+func (c *client) saveStash(ctx context.Context, repoPath string, opts BulkStashOptions, logger Logger, result *RepositoryStashResult) {
+	repoState, err := c.checkRepositoryState(ctx, repoPath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to check repository state"
+		result.Error = err
+		return
+	}
+
+	if repoState.HasConflicts {
+		result.Status = StatusConflict
+		result.Message = fmt.Sprintf("Unresolved conflicts in %d file(s) - skipping", len(repoState.ConflictedFiles))
+		result.Error = fmt.Errorf("repository has unresolved conflicts")
+		return
+	}
+
+	if !repoState.IsDirty {
+		result.Status = StatusClean
+		result.Message = "No local changes to stash"
+		return
+	}
+
+	if opts.DryRun {
+		result.Status = StatusWouldStash
+		result.Message = fmt.Sprintf("Would stash %d changed file(s)", repoState.UncommittedFiles)
+		return
+	}
+
+	stashArgs := []string{"stash", "push"}
+	if opts.IncludeUntracked {
+		stashArgs = append(stashArgs, "-u")
+	}
+	stashArgs = append(stashArgs, "-m", StashMessage(opts.CreatedBy, opts.Message))
+
+	stashResult, err := c.executor.Run(ctx, repoPath, stashArgs...)
+	if err != nil || stashResult.ExitCode != 0 {
+		result.Status = StatusError
+		result.Message = "Failed to stash local changes"
+		if err != nil {
+			result.Error = err
+		} else {
+			result.Error = fmt.Errorf("stash exited with code %d: %s", stashResult.ExitCode, strings.TrimSpace(stashResult.Stderr))
+		}
+		logger.Error("stash failed", "path", result.RelativePath, "error", result.Error)
+		return
+	}
+
+	// Only untracked files and -u not given: git succeeds without creating an entry
+	if strings.Contains(stashResult.Stdout+stashResult.Stderr, "No local changes to save") {
+		result.Status = StatusClean
+		result.Message = "No tracked changes to stash (use include-untracked to stash untracked files)"
+		return
+	}
+
+	entries, err := c.listStashes(ctx, repoPath)
+	if err == nil && len(entries) > 0 {
+		result.Entry = &entries[0]
+		result.Stashes = filterStashes(entries, opts.CreatedBy)
+	}
+
+	result.Status = StatusStashed
+	result.Message = fmt.Sprintf("Stashed %d changed file(s)", repoState.UncommittedFiles)
+	logger.Info("stashed local changes", "path", result.RelativePath)
+}
+
+// listStashEntries lists the matching stash entries
+func (c *client) listStashEntries(ctx context.Context, repoPath string, opts BulkStashOptions, result *RepositoryStashResult) {
+	entries, err := c.listStashes(ctx, repoPath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to list stash entries"
+		result.Error = err
+		return
+	}
+
+	result.Stashes = filterStashes(entries, opts.CreatedBy)
+	if len(result.Stashes) == 0 {
+		result.Status = StatusNoStash
+		result.Message = "No stash entries"
+		return
+	}
+
+	result.Status = StatusHasStash
+	result.Message = fmt.Sprintf("%d stash entr%s", len(result.Stashes), PluralSuffix(len(result.Stashes), "y", "ies"))
+}
+
+// applyStash pops or drops the newest matching stash entry
+func (c *client) applyStash(ctx context.Context, repoPath string, opts BulkStashOptions, logger Logger, result *RepositoryStashResult) {
+	entries, err := c.listStashes(ctx, repoPath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to list stash entries"
+		result.Error = err
+		return
+	}
+
+	matching := filterStashes(entries, opts.CreatedBy)
+	if len(matching) == 0 {
+		result.Status = StatusNoStash
+		result.Message = "No matching stash entries"
+		return
+	}
+
+	entry := matching[0]
+	result.Entry = &entry
+	result.Stashes = matching
+
+	if opts.Action == StashPop {
+		// Popping into a repository in the middle of a merge or rebase makes recovery harder
+		if IsRebaseInProgress(repoPath) || IsMergeInProgress(repoPath) {
+			result.Status = StatusSkipped
+			result.Message = "Merge or rebase in progress - skipping"
+			return
+		}
+	}
+
+	if opts.DryRun {
+		if opts.Action == StashPop {
+			result.Status = StatusWouldPop
+			result.Message = fmt.Sprintf("Would pop %s (%s)", entry.Ref, entry.Message)
+		} else {
+			result.Status = StatusWouldDrop
+			result.Message = fmt.Sprintf("Would drop %s (%s)", entry.Ref, entry.Message)
+		}
+		return
+	}
+
+	runResult, err := c.executor.Run(ctx, repoPath, "stash", string(opts.Action), entry.Ref)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("Failed to %s %s", opts.Action, entry.Ref)
+		result.Error = err
+		return
+	}
+
+	if runResult.ExitCode != 0 {
+		// A pop that conflicts applies the changes but keeps the entry
+		if opts.Action == StashPop {
+			if repoState, stateErr := c.checkRepositoryState(ctx, repoPath); stateErr == nil && repoState.HasConflicts {
+				result.Status = StatusConflict
+				result.Message = fmt.Sprintf("Pop of %s conflicts in %d file(s); the entry was kept", entry.Ref, len(repoState.ConflictedFiles))
+				result.Error = fmt.Errorf("stash pop created conflicts: %s", strings.Join(repoState.ConflictedFiles, ", "))
+				logger.Warn("stash pop conflicts", "path", result.RelativePath, "ref", entry.Ref)
+				return
+			}
+		}
+
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("Failed to %s %s", opts.Action, entry.Ref)
+		result.Error = fmt.Errorf("stash %s exited with code %d: %s", opts.Action, runResult.ExitCode, strings.TrimSpace(runResult.Stderr))
+		logger.Error("stash failed", "path", result.RelativePath, "action", opts.Action, "error", result.Error)
+		return
+	}
+
+	// Report what is left
+	if remaining, err := c.listStashes(ctx, repoPath); err == nil {
+		result.Stashes = filterStashes(remaining, opts.CreatedBy)
+	}
+
+	if opts.Action == StashPop {
+		result.Status = StatusPopped
+		result.Message = fmt.Sprintf("Popped %s (%s)", entry.Ref, entry.Message)
+	} else {
+		result.Status = StatusDropped
+		result.Message = fmt.Sprintf("Dropped %s (%s)", entry.Ref, entry.Message)
+	}
+	logger.Info("stash entry applied", "path", result.RelativePath, "action", opts.Action, "ref", entry.Ref)
+}
+
+// calculateStashSummary creates a summary of stash results by status
+func calculateStashSummary(results []RepositoryStashResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
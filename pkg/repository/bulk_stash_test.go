@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseStashSubject(t *testing.T) {
+	tests := []struct {
+		subject     string
+		wantBranch  string
+		wantMessage string
+		wantCreator string
+	}{
+		{"On main: gz-git:pull: auto-stash before pull", "main", "gz-git:pull: auto-stash before pull", StashCreatorPull},
+		{"On feature/x: gz-git:stash", "feature/x", "gz-git:stash", StashCreatorStash},
+		{"WIP on main: abc1234 Initial commit", "main", "abc1234 Initial commit", ""},
+		{"On main: my own stash", "main", "my own stash", ""},
+		{"unexpected", "", "unexpected", ""},
+	}
+
+	for _, tt := range tests {
+		branch, message := parseStashSubject(tt.subject)
+		if branch != tt.wantBranch || message != tt.wantMessage {
+			t.Errorf("parseStashSubject(%q) = %q, %q, want %q, %q", tt.subject, branch, message, tt.wantBranch, tt.wantMessage)
+		}
+		if got := parseStashCreator(message); got != tt.wantCreator {
+			t.Errorf("parseStashCreator(%q) = %q, want %q", message, got, tt.wantCreator)
+		}
+	}
+}
+
+func TestStashMessage(t *testing.T) {
+	if got := StashMessage(StashCreatorPull, "auto-stash before pull"); got != "gz-git:pull: auto-stash before pull" {
+		t.Errorf("StashMessage = %q", got)
+	}
+	if got := StashMessage(StashCreatorStash, ""); got != "gz-git:stash" {
+		t.Errorf("StashMessage without note = %q", got)
+	}
+}
+
+func TestBulkStash(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	dirtyPath := filepath.Join(tmpDir, "dirty")
+	cleanPath := filepath.Join(tmpDir, "clean")
+	for _, path := range []string{dirtyPath, cleanPath} {
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+		if err := initGitRepoWithCommit(path); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	readme := filepath.Join(dirtyPath, "README.md")
+	if err := os.WriteFile(readme, []byte("changed\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+
+	client := NewClient()
+	ctx := context.Background()
+	run := func(opts BulkStashOptions) map[string]RepositoryStashResult {
+		t.Helper()
+		opts.Directory = tmpDir
+		result, err := client.BulkStash(ctx, opts)
+		if err != nil {
+			t.Fatalf("BulkStash(%s) failed: %v", opts.Action, err)
+		}
+		byPath := make(map[string]RepositoryStashResult)
+		for _, repo := range result.Repositories {
+			byPath[repo.RelativePath] = repo
+		}
+		return byPath
+	}
+
+	// Save a pull-labelled entry, as BulkPull does
+	results := run(BulkStashOptions{Action: StashSave, CreatedBy: StashCreatorPull, Message: "auto-stash before pull"})
+	if got := results["dirty"]; got.Status != StatusStashed || got.Entry == nil || got.Entry.CreatedBy != StashCreatorPull {
+		t.Fatalf("expected dirty repo to be stashed by pull, got %+v", got)
+	}
+	if got := results["clean"].Status; got != StatusClean {
+		t.Errorf("expected clean repo to be skipped, got %s", got)
+	}
+
+	// And an unrelated entry on top of it
+	if err := os.WriteFile(readme, []byte("other change\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+	run(BulkStashOptions{Action: StashSave})
+
+	// Listing by creator only shows the pull entry
+	results = run(BulkStashOptions{Action: StashList, CreatedBy: StashCreatorPull})
+	if got := results["dirty"]; got.Status != StatusHasStash || len(got.Stashes) != 1 || got.Stashes[0].Ref != "stash@{1}" {
+		t.Fatalf("expected one pull entry at stash@{1}, got %+v", got)
+	}
+	if got := results["clean"].Status; got != StatusNoStash {
+		t.Errorf("expected no stash in clean repo, got %s", got)
+	}
+
+	// Dry run leaves the entry in place
+	results = run(BulkStashOptions{Action: StashPop, CreatedBy: StashCreatorPull, DryRun: true})
+	if got := results["dirty"].Status; got != StatusWouldPop {
+		t.Errorf("expected would-pop, got %s", got)
+	}
+
+	// Pop restores exactly what the pull put aside
+	results = run(BulkStashOptions{Action: StashPop, CreatedBy: StashCreatorPull})
+	if got := results["dirty"]; got.Status != StatusPopped || len(got.Stashes) != 0 {
+		t.Fatalf("expected pull entry to be popped, got %+v (%v)", got, got.Error)
+	}
+	data, err := os.ReadFile(readme)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(data) != "changed\n" {
+		t.Errorf("expected pulled-aside change to be restored, got %q", data)
+	}
+
+	// The unrelated entry is still there and can be dropped
+	results = run(BulkStashOptions{Action: StashDrop, CreatedBy: StashCreatorStash})
+	if got := results["dirty"].Status; got != StatusDropped {
+		t.Errorf("expected stash entry to be dropped, got %s", got)
+	}
+	results = run(BulkStashOptions{Action: StashList})
+	if got := results["dirty"].Status; got != StatusNoStash {
+		t.Errorf("expected no entries left, got %s", got)
+	}
+}
+
+func TestBulkStashInvalidOptions(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+
+	if _, err := client.BulkStash(ctx, BulkStashOptions{Directory: t.TempDir()}); err == nil {
+		t.Error("expected error for missing action")
+	}
+	if _, err := client.BulkStash(ctx, BulkStashOptions{Directory: t.TempDir(), Action: "apply"}); err == nil {
+		t.Error("expected error for unknown action")
+	}
+	if _, err := client.BulkStash(ctx, BulkStashOptions{Directory: t.TempDir(), Action: StashList, CreatedBy: "a:b"}); err == nil {
+		t.Error("expected error for invalid creator")
+	}
+
+	// Messages the argument sanitizer would reject fail before any repository is stashed
+	for _, message := range []string{"wip: parser & lexer", "cost $5", "two\nlines"} {
+		_, err := client.BulkStash(ctx, BulkStashOptions{Directory: t.TempDir(), Action: StashSave, Message: message})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != "message" {
+			t.Errorf("BulkStash(message %q) error = %v, want message validation error", message, err)
+		}
+	}
+	if _, err := client.BulkStash(ctx, BulkStashOptions{Directory: t.TempDir(), Action: StashSave, Message: "wip: parser, lexer (v2)"}); err != nil {
+		t.Errorf("BulkStash() with plain message error = %v", err)
+	}
+}
@@ -32,6 +32,8 @@ func (c *client) BulkSwitch(ctx context.Context, opts BulkSwitchOptions) (*BulkS
 	if err != nil {
 		return nil, err
 	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
 
 	// Update opts with initialized values
 	opts.Directory = common.Directory
@@ -80,6 +82,7 @@ func (c *client) BulkSwitch(ctx context.Context, opts BulkSwitchOptions) (*BulkS
 func (c *client) processSwitchRepositories(ctx context.Context, rootDir string, repos []string, opts BulkSwitchOptions, logger Logger) ([]RepositorySwitchResult, error) {
 	results := make([]RepositorySwitchResult, len(repos))
 	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
 
 	// Create error group with concurrency limit
 	g, gctx := errgroup.WithContext(ctx)
@@ -93,11 +96,16 @@ func (c *client) processSwitchRepositories(ctx context.Context, rootDir string,
 			if opts.ProgressCallback != nil {
 				opts.ProgressCallback(i+1, len(repos), repoPath)
 			}
+			events.Started(i+1, repoPath)
 
 			result := c.processSwitchRepository(gctx, rootDir, repoPath, opts, logger)
 
 			mu.Lock()
 			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repoPath, result.Status, result.Duration)
 			mu.Unlock()
 
 			return nil // Don't fail entire operation on single repo error
@@ -117,7 +125,7 @@ func (c *client) processSwitchRepository(ctx context.Context, rootDir, repoPath
 
 	result := RepositorySwitchResult{
 		Path:         repoPath,
-		RelativePath: getRelativePath(rootDir, repoPath),
+		RelativePath: RelativePath(rootDir, repoPath),
 		Duration:     0,
 	}
 
@@ -248,6 +256,10 @@ func (c *client) processSwitchRepository(ctx context.Context, rootDir, repoPath
 		return result
 	}
 
+	// Record the state before the switch so it can be undone
+	result.Undo = c.captureUndoState(ctx, repoPath)
+	defer c.completeUndoState(ctx, repoPath, result.Undo)
+
 	// Perform the switch
 	var switchErr error
 	var created bool
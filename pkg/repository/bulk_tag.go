@@ -0,0 +1,456 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// BulkTag scans for repositories and creates (and optionally pushes) the same annotated tag in each.
+//
+// The operation runs in phases: every repository is verified first (clean working tree,
+// expected branch, tag not present), then tags are created, then pushed. With AllOrNothing,
+// nothing is created unless all repositories pass verification, and tags already created
+// or pushed are deleted again if any repository fails to create or push.
+func (c *client) BulkTag(ctx context.Context, opts BulkTagOptions) (*BulkTagResult, error) {
+	startTime := time.Now()
+
+	// Validate required options
+	if err := validateTagName(opts.Tag); err != nil {
+		return nil, err
+	}
+	if opts.Message == "" {
+		opts.Message = opts.Tag
+	}
+	if opts.Remote == "" {
+		opts.Remote = "origin"
+	}
+
+	// Initialize common settings
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		opts.Parallel,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	// Update opts with initialized values
+	opts.Directory = common.Directory
+	opts.Parallel = common.Parallel
+	opts.MaxDepth = common.MaxDepth
+	opts.Logger = common.Logger
+
+	// Scan and filter repositories
+	filteredRepos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty result
+	if len(filteredRepos) == 0 {
+		return &BulkTagResult{
+			TotalScanned:   totalScanned,
+			TotalProcessed: 0,
+			Repositories:   []RepositoryTagResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+			Tag:            opts.Tag,
+		}, nil
+	}
+
+	results, rolledBack, err := c.processTagRepositories(ctx, opts.Directory, filteredRepos, opts, common.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &BulkTagResult{
+		TotalScanned:   totalScanned,
+		TotalProcessed: len(filteredRepos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateTagSummary(results),
+		Tag:            opts.Tag,
+		RolledBack:     rolledBack,
+	}, nil
+}
+
+// validateTagName checks that name is a usable tag name.
+func validateTagName(name string) error {
+	reason := ""
+	switch {
+	case name == "":
+		reason = "tag name is required"
+	case strings.HasPrefix(name, "-"):
+		reason = "tag name cannot start with '-'"
+	case strings.ContainsAny(name, " ~^:?*[\\"):
+		reason = "tag name contains characters not allowed in Git references"
+	case strings.Contains(name, "..") || strings.Contains(name, "@{"):
+		reason = "tag name contains sequences not allowed in Git references"
+	case strings.HasSuffix(name, ".lock") || strings.HasSuffix(name, "/") || strings.HasSuffix(name, "."):
+		reason = "tag name has an invalid suffix"
+	}
+
+	if reason != "" {
+		return &ValidationError{
+			Field:  "tag",
+			Value:  name,
+			Reason: reason,
+		}
+	}
+	return nil
+}
+
+// processTagRepositories runs the verify, create and push phases.
+// It reports whether created tags were rolled back.
+func (c *client) processTagRepositories(ctx context.Context, rootDir string, repos []string, opts BulkTagOptions, logger Logger) ([]RepositoryTagResult, bool, error) {
+	results := make([]RepositoryTagResult, len(repos))
+	startTimes := make([]time.Time, len(repos))
+
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// emit reports a repository once no later phase can change its result
+	var mu sync.Mutex
+	emitted := make([]bool, len(repos))
+	emit := func(i int) {
+		results[i].Duration = time.Since(startTimes[i])
+		emitted[i] = true
+		if opts.ResultCallback != nil {
+			mu.Lock()
+			opts.ResultCallback(results[i])
+			mu.Unlock()
+		}
+	}
+
+	// Phase 1: verify every repository
+	err := runTagPhase(ctx, len(repos), opts.Parallel, func(gctx context.Context, i int) {
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(repos), repos[i])
+		}
+		events.Started(i+1, repos[i])
+		startTimes[i] = time.Now()
+		results[i] = c.verifyTagRepository(gctx, rootDir, repos[i], opts)
+		events.Finished(i+1, repos[i], results[i].Status, time.Since(startTimes[i]))
+
+		// In all-or-nothing mode, another failure can still skip a verified repository
+		if results[i].Status != StatusWouldTag || (opts.DryRun && !opts.AllOrNothing) {
+			emit(i)
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	finish := func() {
+		for i := range results {
+			if !emitted[i] {
+				emit(i)
+			}
+		}
+	}
+
+	verified := tagIndicesWithStatus(results, StatusWouldTag)
+	failed := len(repos) - len(verified)
+
+	// All-or-nothing: a single verification failure stops the release
+	if opts.AllOrNothing && failed > 0 {
+		for _, i := range verified {
+			results[i].Status = StatusSkipped
+			results[i].Message = fmt.Sprintf("Not tagged: verification failed in %d repositories", failed)
+		}
+		logger.Warn("verification failed, no tags created", "tag", opts.Tag, "failed", failed)
+		finish()
+		return results, false, nil
+	}
+
+	if opts.DryRun {
+		finish()
+		return results, false, nil
+	}
+
+	// Phase 2: create tags; the message is passed as a file since messages
+	// with shell metacharacters or newlines are not accepted as arguments
+	messageFile, err := writeTagMessage(opts.Message)
+	if err != nil {
+		return nil, false, err
+	}
+	defer os.Remove(messageFile)
+
+	err = runTagPhase(ctx, len(verified), opts.Parallel, func(gctx context.Context, n int) {
+		i := verified[n]
+		c.createTag(gctx, repos[i], opts, messageFile, logger, &results[i])
+		if !opts.AllOrNothing && (!opts.Push || results[i].Status != StatusTagged) {
+			emit(i)
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Phase 3: push tags
+	created := tagIndicesWithStatus(results, StatusTagged)
+	if opts.Push && (!opts.AllOrNothing || len(created) == len(verified)) {
+		err = runTagPhase(ctx, len(created), opts.Parallel, func(gctx context.Context, n int) {
+			i := created[n]
+			c.pushTag(gctx, repos[i], opts, logger, &results[i])
+			if !opts.AllOrNothing {
+				emit(i)
+			}
+		})
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Roll back if anything failed in all-or-nothing mode
+	succeeded := len(tagIndicesWithStatus(results, StatusTagged)) + len(tagIndicesWithStatus(results, StatusPushed))
+	if opts.AllOrNothing && succeeded < len(verified) {
+		logger.Warn("tagging failed, rolling back", "tag", opts.Tag)
+		rollbackErr := runTagPhase(ctx, len(verified), opts.Parallel, func(gctx context.Context, n int) {
+			c.rollbackTag(gctx, repos[verified[n]], opts, logger, &results[verified[n]])
+		})
+		if rollbackErr != nil {
+			return nil, false, rollbackErr
+		}
+		finish()
+		return results, true, nil
+	}
+
+	finish()
+	return results, false, nil
+}
+
+// runTagPhase calls fn for indices 0..n-1 with limited parallelism.
+func runTagPhase(ctx context.Context, n, parallel int, fn func(ctx context.Context, i int)) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallel)
+
+	for i := 0; i < n; i++ {
+		i := i // capture loop variable
+		g.Go(func() error {
+			fn(gctx, i)
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	return g.Wait()
+}
+
+// tagIndicesWithStatus returns the indices of results with the given status.
+func tagIndicesWithStatus(results []RepositoryTagResult, status string) []int {
+	var indices []int
+	for i, result := range results {
+		if result.Status == status {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// verifyTagRepository checks that a repository can be tagged.
+// Repositories that pass get StatusWouldTag.
+func (c *client) verifyTagRepository(ctx context.Context, rootDir, repoPath string, opts BulkTagOptions) RepositoryTagResult {
+	result := RepositoryTagResult{
+		Path:         repoPath,
+		RelativePath: RelativePath(rootDir, repoPath),
+	}
+
+	commit, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to resolve HEAD (no commits?)"
+		result.Error = err
+		return result
+	}
+	result.Commit = commit
+
+	branch, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to get current branch"
+		result.Error = err
+		return result
+	}
+	result.Branch = branch
+
+	if opts.Branch != "" && branch != opts.Branch {
+		result.Status = StatusWrongBranch
+		result.Message = fmt.Sprintf("On branch '%s', expected '%s'", branch, opts.Branch)
+		result.Error = fmt.Errorf("not on branch %s", opts.Branch)
+		return result
+	}
+
+	repoState, err := c.checkRepositoryState(ctx, repoPath)
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Failed to check repository state"
+		result.Error = err
+		return result
+	}
+	if repoState.IsDirty || repoState.RebaseInProgress || repoState.MergeInProgress {
+		result.Status = StatusDirty
+		result.Message = fmt.Sprintf("Working tree is not clean (%d files)", repoState.UncommittedFiles)
+		result.Error = fmt.Errorf("uncommitted changes")
+		return result
+	}
+
+	if exists, _ := c.executor.RunQuiet(ctx, repoPath, "rev-parse", "--verify", "--quiet", "refs/tags/"+opts.Tag); exists {
+		result.Status = StatusTagExists
+		result.Message = fmt.Sprintf("Tag '%s' already exists", opts.Tag)
+		result.Error = fmt.Errorf("tag %s already exists", opts.Tag)
+		return result
+	}
+
+	if opts.Push {
+		if _, err := c.executor.RunOutput(ctx, repoPath, "remote", "get-url", opts.Remote); err != nil {
+			result.Status = StatusNoRemote
+			result.Message = fmt.Sprintf("Remote '%s' is not configured", opts.Remote)
+			result.Error = err
+			return result
+		}
+	}
+
+	result.Status = StatusWouldTag
+	result.Message = fmt.Sprintf("Would tag %s on '%s' as '%s'", ShortCommit(commit), branch, opts.Tag)
+	return result
+}
+
+// createTag creates the annotated tag in a verified repository
+func (c *client) createTag(ctx context.Context, repoPath string, opts BulkTagOptions, messageFile string, logger Logger, result *RepositoryTagResult) {
+	tagResult, err := c.executor.Run(ctx, repoPath, "tag", "-a", opts.Tag, "-F", messageFile)
+	if err != nil || tagResult.ExitCode != 0 {
+		result.Status = StatusError
+		result.Message = "Failed to create tag"
+		if err != nil {
+			result.Error = err
+		} else {
+			result.Error = fmt.Errorf("tag exited with code %d: %s", tagResult.ExitCode, strings.TrimSpace(tagResult.Stderr))
+		}
+		logger.Error("tag failed", "path", result.RelativePath, "error", result.Error)
+		return
+	}
+
+	result.Created = true
+	result.Status = StatusTagged
+	result.Message = fmt.Sprintf("Tagged %s as '%s'", ShortCommit(result.Commit), opts.Tag)
+	logger.Info("tag created", "path", result.RelativePath, "tag", opts.Tag)
+}
+
+// writeTagMessage writes the tag message to a temporary file and returns its path.
+func writeTagMessage(message string) (string, error) {
+	file, err := os.CreateTemp("", "gz-git-tag-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to create message file: %w", err)
+	}
+
+	if _, err := file.WriteString(message + "\n"); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write message file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write message file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// pushTag pushes the created tag to the remote
+func (c *client) pushTag(ctx context.Context, repoPath string, opts BulkTagOptions, logger Logger, result *RepositoryTagResult) {
+	pushResult, err := c.executor.Run(ctx, repoPath, "push", opts.Remote, "refs/tags/"+opts.Tag)
+	if err != nil || pushResult.ExitCode != 0 {
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("Tagged, but failed to push to '%s'", opts.Remote)
+		if err != nil {
+			result.Error = err
+		} else {
+			result.Error = fmt.Errorf("push exited with code %d: %s", pushResult.ExitCode, strings.TrimSpace(pushResult.Stderr))
+		}
+		logger.Error("tag push failed", "path", result.RelativePath, "error", result.Error)
+		return
+	}
+
+	result.Pushed = true
+	result.Status = StatusPushed
+	result.Message = fmt.Sprintf("Tagged %s as '%s' and pushed to '%s'", ShortCommit(result.Commit), opts.Tag, opts.Remote)
+	logger.Info("tag pushed", "path", result.RelativePath, "tag", opts.Tag, "remote", opts.Remote)
+}
+
+// rollbackTag deletes a tag created by this operation, remotely first.
+// Failed repositories keep their status; their error is extended if rollback fails.
+func (c *client) rollbackTag(ctx context.Context, repoPath string, opts BulkTagOptions, logger Logger, result *RepositoryTagResult) {
+	if !result.Created {
+		if result.Status == StatusWouldTag {
+			result.Status = StatusSkipped
+			result.Message = "Not tagged: tagging failed in another repository"
+		}
+		return
+	}
+
+	var rollbackErr error
+	if result.Pushed {
+		pushResult, err := c.executor.Run(ctx, repoPath, "push", opts.Remote, "--delete", "refs/tags/"+opts.Tag)
+		if err != nil {
+			rollbackErr = err
+		} else if pushResult.ExitCode != 0 {
+			rollbackErr = fmt.Errorf("remote tag delete exited with code %d: %s", pushResult.ExitCode, strings.TrimSpace(pushResult.Stderr))
+		} else {
+			result.Pushed = false
+		}
+	}
+
+	if rollbackErr == nil {
+		deleteResult, err := c.executor.Run(ctx, repoPath, "tag", "-d", opts.Tag)
+		if err != nil {
+			rollbackErr = err
+		} else if deleteResult.ExitCode != 0 {
+			rollbackErr = fmt.Errorf("tag delete exited with code %d: %s", deleteResult.ExitCode, strings.TrimSpace(deleteResult.Stderr))
+		} else {
+			result.Created = false
+		}
+	}
+
+	if rollbackErr != nil {
+		if result.Error != nil {
+			rollbackErr = fmt.Errorf("%v; rollback failed: %w", result.Error, rollbackErr)
+		}
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("Rollback of '%s' failed; delete the tag manually", opts.Tag)
+		result.Error = rollbackErr
+		logger.Error("tag rollback failed", "path", result.RelativePath, "error", rollbackErr)
+		return
+	}
+
+	// Repositories that failed to push keep their error, but the local tag is gone
+	if result.Status == StatusError {
+		result.Message += " (tag deleted)"
+		return
+	}
+
+	result.Status = StatusRolledBack
+	result.Message = fmt.Sprintf("Tag '%s' deleted: tagging failed in another repository", opts.Tag)
+	logger.Info("tag rolled back", "path", result.RelativePath, "tag", opts.Tag)
+}
+
+// calculateTagSummary creates a summary of tag results by status
+func calculateTagSummary(results []RepositoryTagResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// initTagRepo creates a repository with a commit and, if remote is not empty,
+// an origin remote pointing at a new bare repository at that path.
+func initTagRepo(t *testing.T, path, remote string) {
+	t.Helper()
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatalf("Failed to create %s: %v", path, err)
+	}
+	if err := initGitRepoWithCommit(path); err != nil {
+		t.Skipf("Skipping test: git not available: %v", err)
+	}
+	if remote == "" {
+		return
+	}
+
+	for _, args := range [][]string{
+		{"init", "--bare", remote},
+		{"-C", path, "remote", "add", "origin", remote},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+}
+
+// tagExists reports whether tag exists in the repository at path
+func tagExists(t *testing.T, path, tag string) bool {
+	t.Helper()
+	return exec.Command("git", "-C", path, "rev-parse", "--verify", "--quiet", "refs/tags/"+tag).Run() == nil
+}
+
+func TestValidateTagName(t *testing.T) {
+	for _, name := range []string{"v1.4.0", "release/2024-01", "v1.0.0-rc.1"} {
+		if err := validateTagName(name); err != nil {
+			t.Errorf("validateTagName(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"", "-v1", "v1 0", "v1..0", "v1.lock", "v1:0", "v1/"} {
+		if err := validateTagName(name); err == nil {
+			t.Errorf("validateTagName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestBulkTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	remotes := filepath.Join(tmpDir, "remotes")
+
+	initTagRepo(t, filepath.Join(workDir, "api"), filepath.Join(remotes, "api.git"))
+	initTagRepo(t, filepath.Join(workDir, "web"), filepath.Join(remotes, "web.git"))
+
+	client := NewClient()
+	ctx := context.Background()
+
+	// Dry run only verifies
+	result, err := client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v1.0.0", Push: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("BulkTag dry-run failed: %v", err)
+	}
+	if result.Summary[StatusWouldTag] != 2 || tagExists(t, filepath.Join(workDir, "api"), "v1.0.0") {
+		t.Fatalf("expected 2 would-tag results and no tags, got %v", result.Summary)
+	}
+
+	// Create and push; release notes may span lines and contain shell metacharacters
+	message := "Release 1.0.0: fixes & improvements\n\n- cost is $5 | was <10>"
+	result, err = client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v1.0.0", Message: message, Push: true})
+	if err != nil {
+		t.Fatalf("BulkTag failed: %v", err)
+	}
+	if result.Summary[StatusPushed] != 2 {
+		t.Fatalf("expected 2 pushed results, got %v (%+v)", result.Summary, result.Repositories)
+	}
+	if got := gitOutput(t, filepath.Join(workDir, "api"), "tag", "-l", "--format=%(contents)", "v1.0.0"); got != message {
+		t.Errorf("tag message = %q, want %q", got, message)
+	}
+	for _, name := range []string{"api", "web"} {
+		if !tagExists(t, filepath.Join(remotes, name+".git"), "v1.0.0") {
+			t.Errorf("expected tag on remote %s", name)
+		}
+	}
+
+	// Existing tags are reported, not overwritten
+	result, err = client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v1.0.0"})
+	if err != nil {
+		t.Fatalf("BulkTag failed: %v", err)
+	}
+	if result.Summary[StatusTagExists] != 2 {
+		t.Errorf("expected 2 tag-exists results, got %v", result.Summary)
+	}
+
+	// Wrong branch
+	result, err = client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v1.0.1", Branch: "release"})
+	if err != nil {
+		t.Fatalf("BulkTag failed: %v", err)
+	}
+	if result.Summary[StatusWrongBranch] != 2 {
+		t.Errorf("expected 2 wrong-branch results, got %v", result.Summary)
+	}
+}
+
+func TestBulkTagAllOrNothing(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	remotes := filepath.Join(tmpDir, "remotes")
+
+	apiPath := filepath.Join(workDir, "api")
+	webPath := filepath.Join(workDir, "web")
+	initTagRepo(t, apiPath, filepath.Join(remotes, "api.git"))
+	initTagRepo(t, webPath, filepath.Join(remotes, "web.git"))
+
+	client := NewClient()
+	ctx := context.Background()
+
+	// A dirty repository blocks tagging everywhere
+	if err := os.WriteFile(filepath.Join(webPath, "README.md"), []byte("dirty\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+	result, err := client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v2.0.0", AllOrNothing: true})
+	if err != nil {
+		t.Fatalf("BulkTag failed: %v", err)
+	}
+	if result.Summary[StatusDirty] != 1 || result.Summary[StatusSkipped] != 1 || tagExists(t, apiPath, "v2.0.0") {
+		t.Fatalf("expected no tags after failed verification, got %v", result.Summary)
+	}
+	if out, err := exec.Command("git", "-C", webPath, "checkout", "README.md").CombinedOutput(); err != nil {
+		t.Fatalf("Failed to restore file: %v\n%s", err, out)
+	}
+
+	// A push failure rolls back tags already pushed elsewhere
+	if err := os.RemoveAll(filepath.Join(remotes, "web.git")); err != nil {
+		t.Fatalf("Failed to remove remote: %v", err)
+	}
+	result, err = client.BulkTag(ctx, BulkTagOptions{Directory: workDir, Tag: "v2.0.0", Push: true, AllOrNothing: true})
+	if err != nil {
+		t.Fatalf("BulkTag failed: %v", err)
+	}
+	if !result.RolledBack {
+		t.Errorf("expected rollback, got %+v", result.Repositories)
+	}
+	if result.Summary[StatusRolledBack] != 1 || result.Summary[StatusError] != 1 {
+		t.Errorf("expected 1 rolled-back and 1 error, got %v", result.Summary)
+	}
+	if tagExists(t, apiPath, "v2.0.0") || tagExists(t, webPath, "v2.0.0") {
+		t.Error("expected local tags to be deleted")
+	}
+	if tagExists(t, filepath.Join(remotes, "api.git"), "v2.0.0") {
+		t.Error("expected pushed tag to be deleted from the remote")
+	}
+}
+
+func TestBulkTagResultCallback(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(tmpDir, "work")
+	remotes := filepath.Join(tmpDir, "remotes")
+
+	apiPath := filepath.Join(workDir, "api")
+	initTagRepo(t, apiPath, filepath.Join(remotes, "api.git"))
+	initTagRepo(t, filepath.Join(workDir, "web"), filepath.Join(remotes, "web.git"))
+	if err := os.WriteFile(filepath.Join(apiPath, "README.md"), []byte("dirty\n"), 0o644); err != nil {
+		t.Fatalf("Failed to modify file: %v", err)
+	}
+
+	run := func(allOrNothing bool) []RepositoryTagResult {
+		t.Helper()
+		var streamed []RepositoryTagResult
+		_, err := NewClient().BulkTag(context.Background(), BulkTagOptions{
+			Directory:      workDir,
+			Tag:            "v3.0.0",
+			Push:           true,
+			AllOrNothing:   allOrNothing,
+			ResultCallback: func(r RepositoryTagResult) { streamed = append(streamed, r) },
+		})
+		if err != nil {
+			t.Fatalf("BulkTag failed: %v", err)
+		}
+		return streamed
+	}
+
+	// The failed verification is streamed before the other repository is tagged and pushed
+	streamed := run(false)
+	if len(streamed) != 2 || streamed[0].RelativePath != "api" || streamed[0].Status != StatusDirty ||
+		streamed[1].Status != StatusPushed {
+		t.Errorf("streamed = %+v, want dirty api, then pushed web", streamed)
+	}
+
+	// All-or-nothing reports the final status of every repository once
+	if err := exec.Command("git", "-C", filepath.Join(workDir, "web"), "tag", "-d", "v3.0.0").Run(); err != nil {
+		t.Fatalf("Failed to delete tag: %v", err)
+	}
+	streamed = run(true)
+	if len(streamed) != 2 || streamed[0].Status != StatusDirty || streamed[1].Status != StatusSkipped {
+		t.Errorf("streamed = %+v, want dirty api, then skipped web", streamed)
+	}
+}
@@ -15,6 +15,9 @@ import (
 type client struct {
 	executor *gitcmd.Executor
 	logger   Logger
+
+	// scanIndex enables the persistent repository discovery index (nil when disabled)
+	scanIndex *ScanIndexOptions
 }
 
 // NewClient creates a new repository client with the given options.
@@ -61,6 +64,14 @@ func WithExecutor(executor *gitcmd.Executor) ClientOption {
 	}
 }
 
+// WithScanIndex enables the persistent repository discovery index for bulk scans.
+// Repeated scans of the same tree only re-read directories that changed.
+func WithScanIndex(opts ScanIndexOptions) ClientOption {
+	return func(c *client) {
+		c.scanIndex = &opts
+	}
+}
+
 // Open opens an existing Git repository at the specified path.
 // Returns an error if the path is not a valid Git repository.
 //
@@ -104,6 +115,35 @@ func (c *client) Open(ctx context.Context, path string) (*Repository, error) {
 	}, nil
 }
 
+// openBare returns the bare repository at path, such as one created with
+// clone --bare or --mirror, which Open does not accept.
+func openBare(path string) (*Repository, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+	if !isBareRepository(absPath) {
+		return nil, fmt.Errorf("not a bare Git repository: %s", absPath)
+	}
+
+	return &Repository{
+		Path:   absPath,
+		GitDir: absPath,
+		IsBare: true,
+	}, nil
+}
+
+// isBareRepository reports whether path is the root of a bare repository,
+// such as one created with clone --bare or --mirror.
+func isBareRepository(path string) bool {
+	for _, name := range []string{"HEAD", "objects", "refs"} {
+		if _, err := os.Stat(filepath.Join(path, name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Clone clones a Git repository from the specified URL.
 // The repository is cloned into the directory specified in opts.Destination.
 //
@@ -136,6 +176,22 @@ func (c *client) Clone(ctx context.Context, opts CloneOptions) (*Repository, err
 		}
 	}
 
+	if err := ValidateCloneFilter(opts.Filter); err != nil {
+		return nil, err
+	}
+
+	sparsePaths, err := normalizeSparsePaths(opts.SparsePaths)
+	if err != nil {
+		return nil, err
+	}
+	if len(sparsePaths) > 0 && (opts.Bare || opts.Mirror) {
+		return nil, &ValidationError{
+			Field:  "SparsePaths",
+			Value:  strings.Join(sparsePaths, ","),
+			Reason: "sparse checkout requires a working tree (not supported with bare or mirror)",
+		}
+	}
+
 	// Build Git clone command arguments
 	args := []string{"clone"}
 
@@ -159,6 +215,14 @@ func (c *client) Clone(ctx context.Context, opts CloneOptions) (*Repository, err
 		args = append(args, "--mirror")
 	}
 
+	if opts.Filter != "" {
+		args = append(args, "--filter="+opts.Filter)
+	}
+
+	if len(sparsePaths) > 0 {
+		args = append(args, "--sparse")
+	}
+
 	if opts.Quiet {
 		args = append(args, "--quiet")
 	}
@@ -192,6 +256,12 @@ func (c *client) Clone(ctx context.Context, opts CloneOptions) (*Repository, err
 				if opts.Mirror {
 					argsWithoutBranch = append(argsWithoutBranch, "--mirror")
 				}
+				if opts.Filter != "" {
+					argsWithoutBranch = append(argsWithoutBranch, "--filter="+opts.Filter)
+				}
+				if len(sparsePaths) > 0 {
+					argsWithoutBranch = append(argsWithoutBranch, "--sparse")
+				}
 				if opts.Quiet {
 					argsWithoutBranch = append(argsWithoutBranch, "--quiet")
 				}
@@ -232,6 +302,18 @@ func (c *client) Clone(ctx context.Context, opts CloneOptions) (*Repository, err
 		}
 	}
 
+	// Servers without partial clone support send everything
+	if opts.Filter != "" && strings.Contains(result.Stderr, "filtering not recognized by server") {
+		c.logger.Warn("server does not support partial clone; full clone made", "path", opts.Destination)
+	}
+
+	// Restrict the working tree to the sparse checkout directories
+	if len(sparsePaths) > 0 {
+		if err := c.setSparseCheckout(ctx, opts.Destination, sparsePaths); err != nil {
+			return nil, err
+		}
+	}
+
 	// Report progress if available
 	if opts.Progress != nil {
 		opts.Progress.Done()
@@ -240,6 +322,9 @@ func (c *client) Clone(ctx context.Context, opts CloneOptions) (*Repository, err
 	c.logger.Info("Cloned repository from %s to %s", opts.URL, opts.Destination)
 
 	// Open the cloned repository
+	if opts.Bare || opts.Mirror {
+		return openBare(opts.Destination)
+	}
 	return c.Open(ctx, opts.Destination)
 }
 
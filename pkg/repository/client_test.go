@@ -184,6 +184,30 @@ func TestCloneValidation(t *testing.T) {
 	}
 }
 
+// TestCloneMirror verifies that mirror clones are returned as bare repositories
+// while Open keeps rejecting them.
+func TestCloneMirror(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	source := filepath.Join(tmpDir, "source")
+	initTagRepo(t, source, "")
+
+	mirrorPath := filepath.Join(tmpDir, "mirror.git")
+	repo, err := client.Clone(ctx, CloneOptions{URL: source, Destination: mirrorPath, Mirror: true, Quiet: true})
+	if err != nil {
+		t.Fatalf("Clone() error = %v", err)
+	}
+	if !repo.IsBare || repo.GitDir != repo.Path {
+		t.Errorf("Clone() = %+v, want bare repository", repo)
+	}
+
+	if _, err := client.Open(ctx, mirrorPath); err == nil {
+		t.Error("Open() on a bare repository expected error")
+	}
+}
+
 // TestParseStatus verifies status parsing logic.
 func TestParseStatus(t *testing.T) {
 	tests := []struct {
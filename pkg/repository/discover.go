@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+)
+
+// DiscoverRepositories scans for repositories and applies the bulk selection filters
+func (c *client) DiscoverRepositories(ctx context.Context, opts DiscoverOptions) (*DiscoverResult, error) {
+	common, err := initializeBulkOperation(
+		opts.Directory,
+		0,
+		opts.MaxDepth,
+		opts.IncludeSubmodules,
+		opts.IncludePattern,
+		opts.ExcludePattern,
+		opts.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	common.withGroups(opts.Groups, opts.ExcludeGroups, opts.Workspace)
+	common.withRepositories(opts.OnlyRepositories)
+
+	repos, totalScanned, err := c.scanAndFilterRepositories(ctx, common)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscoverResult{
+		Directory:    common.Directory,
+		Repositories: repos,
+		TotalScanned: totalScanned,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiscoverRepositories(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, name := range []string{"api", "web", "docs"} {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+		if err := initGitRepo(path); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	result, err := NewClient().DiscoverRepositories(context.Background(), DiscoverOptions{
+		Directory:      tmpDir,
+		ExcludePattern: "docs",
+	})
+	if err != nil {
+		t.Fatalf("DiscoverRepositories failed: %v", err)
+	}
+
+	if result.TotalScanned != 3 {
+		t.Errorf("TotalScanned = %d, want 3", result.TotalScanned)
+	}
+	if len(result.Repositories) != 2 {
+		t.Fatalf("expected 2 repositories, got %v", result.Repositories)
+	}
+	for _, path := range result.Repositories {
+		if !filepath.IsAbs(path) {
+			t.Errorf("expected absolute path, got %s", path)
+		}
+	}
+}
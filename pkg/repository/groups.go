@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FindWorkspaceManifest searches dir and its parent directories for a
+// workspace manifest (DefaultWorkspaceManifest) and returns its path.
+func FindWorkspaceManifest(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+
+	for current := absDir; ; {
+		candidate := filepath.Join(current, DefaultWorkspaceManifest)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", fmt.Errorf("no %s found in %s or any parent directory", DefaultWorkspaceManifest, absDir)
+		}
+		current = parent
+	}
+}
+
+// GroupNames returns all group names defined by the manifest, sorted.
+func (m *WorkspaceManifest) GroupNames() []string {
+	seen := make(map[string]bool)
+	for name := range m.Groups {
+		seen[name] = true
+	}
+	for _, entry := range m.Repositories {
+		for _, name := range entry.Groups {
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GroupsFor returns the groups the repository at path belongs to, sorted.
+// A repository belongs to a group if a manifest entry targeting path lists the
+// group, or if its path relative to the manifest root matches one of the group's patterns.
+func (m *WorkspaceManifest) GroupsFor(path string) []string {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = filepath.Clean(path)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, entry := range m.Repositories {
+		if len(entry.Groups) == 0 {
+			continue
+		}
+		if target, err := m.TargetPath(entry); err == nil && target == absPath {
+			for _, name := range entry.Groups {
+				seen[name] = true
+			}
+		}
+	}
+
+	if rel, err := filepath.Rel(m.Root, absPath); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		for name, patterns := range m.Groups {
+			for _, pattern := range patterns {
+				if matchGroupPattern(pattern, rel) {
+					seen[name] = true
+					break
+				}
+			}
+		}
+	}
+
+	groups := make([]string, 0, len(seen))
+	for name := range seen {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// matchGroupPattern reports whether rel matches a group pattern.
+// Patterns use filepath.Match syntax and are matched against the path relative
+// to the manifest root; a pattern without wildcards also matches that exact path.
+func matchGroupPattern(pattern, rel string) bool {
+	pattern = filepath.Clean(filepath.FromSlash(pattern))
+	if pattern == rel {
+		return true
+	}
+	matched, err := filepath.Match(pattern, rel)
+	return err == nil && matched
+}
+
+// validateGroups checks group names and patterns.
+func (m *WorkspaceManifest) validateGroups() error {
+	for name, patterns := range m.Groups {
+		if strings.TrimSpace(name) == "" {
+			return &ValidationError{
+				Field:  "groups",
+				Value:  name,
+				Reason: "group name cannot be empty",
+			}
+		}
+		for _, pattern := range patterns {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return &ValidationError{
+					Field:  fmt.Sprintf("groups.%s", name),
+					Value:  pattern,
+					Reason: fmt.Sprintf("invalid pattern: %v", err),
+				}
+			}
+		}
+	}
+
+	for i, entry := range m.Repositories {
+		for _, name := range entry.Groups {
+			if strings.TrimSpace(name) == "" {
+				return &ValidationError{
+					Field:  fmt.Sprintf("repositories[%d].groups", i),
+					Value:  name,
+					Reason: "group name cannot be empty",
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupFilter selects repositories by workspace group membership.
+type groupFilter struct {
+	manifest *WorkspaceManifest
+	include  []string
+	exclude  []string
+}
+
+// newGroupFilter creates a group filter, or returns nil if no groups are selected.
+// If manifest is nil, the workspace manifest is searched from dir upwards.
+// Unknown group names are rejected to catch typos early.
+func newGroupFilter(include, exclude []string, manifest *WorkspaceManifest, dir string) (*groupFilter, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+
+	if manifest == nil {
+		path, err := FindWorkspaceManifest(dir)
+		if err != nil {
+			return nil, fmt.Errorf("group selection requires a workspace manifest: %w", err)
+		}
+		manifest, err = LoadWorkspaceManifest(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	known := make(map[string]bool)
+	for _, name := range manifest.GroupNames() {
+		known[name] = true
+	}
+	for _, name := range append(append([]string{}, include...), exclude...) {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown group %q (defined groups: %s)", name, strings.Join(manifest.GroupNames(), ", "))
+		}
+	}
+
+	return &groupFilter{
+		manifest: manifest,
+		include:  include,
+		exclude:  exclude,
+	}, nil
+}
+
+// match reports whether the repository at path passes the group selection:
+// it must belong to at least one included group (if any) and to no excluded group.
+func (f *groupFilter) match(path string) bool {
+	groups := f.manifest.GroupsFor(path)
+
+	for _, name := range f.exclude {
+		if containsString(groups, name) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, name := range f.include {
+		if containsString(groups, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func sampleGroupManifest(t *testing.T, root string) *WorkspaceManifest {
+	t.Helper()
+	manifest, err := ParseWorkspaceManifest([]byte(`
+repositories:
+  - url: https://github.com/user/api.git
+    path: services/api
+    groups: [backend, core]
+  - url: https://github.com/user/worker.git
+    path: services/worker
+    groups: [backend]
+groups:
+  frontend: ["web/*"]
+  core: ["libs/shared"]
+`), root)
+	if err != nil {
+		t.Fatalf("ParseWorkspaceManifest failed: %v", err)
+	}
+	return manifest
+}
+
+func TestWorkspaceManifestGroups(t *testing.T) {
+	root := t.TempDir()
+	manifest := sampleGroupManifest(t, root)
+
+	if got, want := manifest.GroupNames(), []string{"backend", "core", "frontend"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupNames() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"services/api", []string{"backend", "core"}},
+		{"services/worker", []string{"backend"}},
+		{"web/app", []string{"frontend"}},
+		{"web/app/nested", []string{}},
+		{"libs/shared", []string{"core"}},
+		{"docs", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := manifest.GroupsFor(filepath.Join(root, tt.path)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GroupsFor(%s) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestWorkspaceManifestInvalidGroupPattern(t *testing.T) {
+	_, err := ParseWorkspaceManifest([]byte(`
+repositories:
+  - url: https://github.com/user/api.git
+groups:
+  broken: ["web/["]
+`), "/work")
+	if err == nil {
+		t.Error("expected error for invalid group pattern")
+	}
+}
+
+func TestFindWorkspaceManifest(t *testing.T) {
+	root := t.TempDir()
+	manifestPath := filepath.Join(root, DefaultWorkspaceManifest)
+	if err := os.WriteFile(manifestPath, []byte("repositories: []\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	found, err := FindWorkspaceManifest(nested)
+	if err != nil {
+		t.Fatalf("FindWorkspaceManifest failed: %v", err)
+	}
+	if found != manifestPath {
+		t.Errorf("FindWorkspaceManifest = %q, want %q", found, manifestPath)
+	}
+}
+
+func TestFilterRepositoriesByGroup(t *testing.T) {
+	root := t.TempDir()
+	manifest := sampleGroupManifest(t, root)
+
+	repos := []string{
+		filepath.Join(root, "services/api"),
+		filepath.Join(root, "services/worker"),
+		filepath.Join(root, "web/app"),
+		filepath.Join(root, "docs"),
+	}
+
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{
+		{"include", []string{"backend"}, nil, []string{"services/api", "services/worker"}},
+		{"union", []string{"core", "frontend"}, nil, []string{"services/api", "web/app"}},
+		{"exclude", nil, []string{"backend"}, []string{"web/app", "docs"}},
+		{"include and exclude", []string{"backend"}, []string{"core"}, []string{"services/worker"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := newGroupFilter(tt.include, tt.exclude, manifest, root)
+			if err != nil {
+				t.Fatalf("newGroupFilter failed: %v", err)
+			}
+
+			filtered, err := filterRepositories(repos, "", "", groups, &noopLogger{})
+			if err != nil {
+				t.Fatalf("filterRepositories failed: %v", err)
+			}
+
+			got := make([]string, 0, len(filtered))
+			for _, repo := range filtered {
+				rel, _ := filepath.Rel(root, repo)
+				got = append(got, filepath.ToSlash(rel))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filtered = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGroupFilter(t *testing.T) {
+	root := t.TempDir()
+	manifest := sampleGroupManifest(t, root)
+
+	if groups, err := newGroupFilter(nil, nil, nil, root); groups != nil || err != nil {
+		t.Errorf("expected no filter without groups, got %v, %v", groups, err)
+	}
+
+	if _, err := newGroupFilter([]string{"backnd"}, nil, manifest, root); err == nil || !strings.Contains(err.Error(), "backnd") {
+		t.Errorf("expected unknown group error, got %v", err)
+	}
+
+	if _, err := newGroupFilter([]string{"backend"}, nil, nil, root); err == nil {
+		t.Error("expected error when no workspace manifest can be found")
+	}
+}
+
+func TestBulkStatusGroups(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"services/api", "web/app"} {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := initGitRepo(path); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	manifest := `repositories:
+  - url: https://github.com/user/api.git
+    path: services/api
+    groups: [backend]
+groups:
+  frontend: ["web/*"]
+`
+	if err := os.WriteFile(filepath.Join(root, DefaultWorkspaceManifest), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	client := NewClient()
+	ctx := context.Background()
+
+	// The manifest is found automatically in the scanned directory
+	result, err := client.BulkStatus(ctx, BulkStatusOptions{
+		Directory: root,
+		MaxDepth:  2,
+		Groups:    []string{"frontend"},
+	})
+	if err != nil {
+		t.Fatalf("BulkStatus failed: %v", err)
+	}
+	if len(result.Repositories) != 1 || result.Repositories[0].RelativePath != filepath.Join("web", "app") {
+		t.Errorf("expected only web/app, got %+v", result.Repositories)
+	}
+
+	if _, err := client.BulkStatus(ctx, BulkStatusOptions{Directory: root, MaxDepth: 2, Groups: []string{"missing"}}); err == nil {
+		t.Error("expected error for unknown group")
+	}
+}
+
+func TestSyncGroups(t *testing.T) {
+	root := t.TempDir()
+	manifest := sampleGroupManifest(t, root)
+
+	result, err := NewClient().Sync(context.Background(), SyncOptions{
+		Manifest:      manifest,
+		DryRun:        true,
+		Groups:        []string{"backend"},
+		ExcludeGroups: []string{"core"},
+	})
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if len(result.Repositories) != 1 || result.Repositories[0].Path != filepath.Join(root, "services/worker") {
+		t.Errorf("expected only services/worker, got %+v", result.Repositories)
+	}
+}
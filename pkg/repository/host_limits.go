@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// HostLimits caps the number of concurrent operations per remote host,
+// keyed by host name (e.g. {"git.internal": 3}). The limits apply on top of
+// the Parallel worker limit; hosts without a limit are only bound by Parallel.
+type HostLimits map[string]int
+
+// ParseHostLimits parses host limits given as "host=N", e.g. "git.internal=3".
+// Host names are case-insensitive; a port is ignored.
+func ParseHostLimits(specs []string) (HostLimits, error) {
+	limits := make(HostLimits, len(specs))
+	for _, spec := range specs {
+		host, value, ok := strings.Cut(spec, "=")
+		host = normalizeHost(host)
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid host limit %q: expected host=N", spec)
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || limit < 1 {
+			return nil, fmt.Errorf("invalid host limit %q: limit must be a positive number", spec)
+		}
+		limits[host] = limit
+	}
+	return limits, nil
+}
+
+// String returns the limits as "host=N" pairs sorted by host.
+func (l HostLimits) String() string {
+	parts := make([]string, 0, len(l))
+	for host, limit := range l {
+		parts = append(parts, fmt.Sprintf("%s=%d", host, limit))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// RemoteHost returns the lower-cased host name of a remote URL, supporting
+// URL syntax (https://host/path, ssh://user@host:22/path) and scp-like syntax
+// (git@host:path). It returns "" for local paths and file URLs.
+func RemoteHost(remoteURL string) string {
+	remoteURL = strings.TrimSpace(remoteURL)
+	if remoteURL == "" {
+		return ""
+	}
+
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return ""
+		}
+		return normalizeHost(u.Host)
+	}
+
+	// scp-like syntax: [user@]host:path, where host contains no slash
+	colon := strings.Index(remoteURL, ":")
+	if colon <= 0 || strings.Contains(remoteURL[:colon], "/") {
+		return ""
+	}
+	host := remoteURL[:colon]
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	return normalizeHost(host)
+}
+
+// normalizeHost lower-cases a host name and strips user info and port.
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	if colon := strings.LastIndex(host, ":"); colon >= 0 && !strings.HasSuffix(host, "]") {
+		host = host[:colon]
+	}
+	return strings.Trim(host, "[]")
+}
+
+// hostLimiter schedules work according to HostLimits.
+// A nil hostLimiter imposes no limits.
+type hostLimiter struct {
+	limits map[string]int
+}
+
+// newHostLimiter creates a limiter for limits, or nil if there are none.
+func newHostLimiter(limits HostLimits) *hostLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	l := &hostLimiter{limits: make(map[string]int, len(limits))}
+	for host, limit := range limits {
+		if host = normalizeHost(host); host != "" && limit > 0 {
+			l.limits[host] = limit
+		}
+	}
+	return l
+}
+
+// limitedHost returns host if it has a limit, or "" otherwise.
+func (l *hostLimiter) limitedHost(host string) string {
+	if l == nil {
+		return ""
+	}
+	if _, ok := l.limits[host]; !ok {
+		return ""
+	}
+	return host
+}
+
+// run calls fn for items 0..n-1 with at most parallel calls at once and at
+// most the limit of each host, where remoteURLs[i] is the remote of item i
+// (remoteURLs may be nil when there are no limits). Items wait for their host
+// before they take one of the parallel slots, so items queued behind a
+// throttled host never keep other hosts from using the slots. Items start
+// in order, except that items of a host at its limit are passed over.
+// run returns when all calls have returned.
+func (l *hostLimiter) run(ctx context.Context, n, parallel int, remoteURLs []string, fn func(ctx context.Context, i int)) {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	pending := make([]int, n)
+	for i := range pending {
+		pending[i] = i
+	}
+	active := make(map[string]int)
+	done := make(chan string)
+	running := 0
+
+	for len(pending) > 0 {
+		// Start the first pending items whose host has a free slot
+		for j := 0; j < len(pending) && running < parallel; {
+			i := pending[j]
+			host := ""
+			if remoteURLs != nil {
+				host = l.limitedHost(RemoteHost(remoteURLs[i]))
+			}
+			if host != "" && active[host] >= l.limits[host] {
+				j++
+				continue
+			}
+
+			pending = append(pending[:j], pending[j+1:]...)
+			active[host]++
+			running++
+			go func() {
+				fn(ctx, i)
+				done <- host
+			}()
+		}
+
+		host := <-done
+		active[host]--
+		running--
+	}
+
+	for ; running > 0; running-- {
+		<-done
+	}
+}
+
+// remoteURLs returns the URL of the given remote of each repository, or nil
+// if hosts imposes no limits.
+func (c *client) remoteURLs(ctx context.Context, hosts *hostLimiter, repos []string, remote string, parallel int) []string {
+	if hosts == nil {
+		return nil
+	}
+
+	result := make([]string, len(repos))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(parallel, 1))
+	for i, repoPath := range repos {
+		i, repoPath := i, repoPath // capture loop variables
+		g.Go(func() error {
+			result[i], _ = c.executor.RunOutput(gctx, repoPath, "remote", "get-url", remote)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return result
+}
@@ -53,6 +53,54 @@ type Client interface {
 	// This is useful for switching branches across multiple repositories at once.
 	BulkSwitch(ctx context.Context, opts BulkSwitchOptions) (*BulkSwitchResult, error)
 
+	// BulkClone clones a list of repositories into a directory in parallel.
+	// This is useful for standing up many repositories at once, e.g. on fresh CI runners.
+	BulkClone(ctx context.Context, opts BulkCloneOptions) (*BulkCloneResult, error)
+
+	// BulkExec scans for repositories and runs a git command in each of them in parallel.
+	// This is useful for ad-hoc queries or changes that have no dedicated bulk operation.
+	BulkExec(ctx context.Context, opts BulkExecOptions) (*BulkExecResult, error)
+
+	// BulkStash scans for repositories and saves, lists, pops or drops stash entries in parallel.
+	// This is useful for finding and restoring changes put aside by bulk operations such as pull.
+	BulkStash(ctx context.Context, opts BulkStashOptions) (*BulkStashResult, error)
+
+	// BulkTag scans for repositories and creates (and optionally pushes) the same annotated tag in each.
+	// This is useful for coordinated releases across many repositories.
+	BulkTag(ctx context.Context, opts BulkTagOptions) (*BulkTagResult, error)
+
+	// BulkRemoteSetURL scans for repositories and rewrites a remote URL in each with a regular expression.
+	// This is useful for moving many clones to a new Git host.
+	BulkRemoteSetURL(ctx context.Context, opts BulkRemoteSetURLOptions) (*BulkRemoteSetURLResult, error)
+
+	// BulkMaintain scans for repositories and runs gc, prune, repack and commit-graph writes in parallel.
+	// This is useful for reclaiming disk space taken by loose objects and stale packs.
+	BulkMaintain(ctx context.Context, opts BulkMaintainOptions) (*BulkMaintainResult, error)
+
+	// BulkDoctor scans for repositories and checks each for common problems, optionally fixing safe ones.
+	// This is useful for finding repositories that bulk pull and push would skip or fail on.
+	BulkDoctor(ctx context.Context, opts BulkDoctorOptions) (*BulkDoctorResult, error)
+
+	// BulkIncoming scans for repositories and lists the upstream commits not yet in each local branch.
+	// It reads remote-tracking branches only, so run a fetch first for current results.
+	BulkIncoming(ctx context.Context, opts BulkIncomingOptions) (*BulkIncomingResult, error)
+
+	// BulkBackup scans for repositories and keeps a bare mirror of each remote up to date.
+	// Mirrors are created on the first run and fetched incrementally afterwards.
+	BulkBackup(ctx context.Context, opts BulkBackupOptions) (*BulkBackupResult, error)
+
+	// DiscoverRepositories scans for repositories and applies the bulk selection filters without touching them.
+	// This is useful for building workspace-wide operations outside this package.
+	DiscoverRepositories(ctx context.Context, opts DiscoverOptions) (*DiscoverResult, error)
+
+	// Sync clones missing repositories and updates existing ones as declared by a workspace manifest.
+	// This is useful for bootstrapping or refreshing a whole workspace from a single file.
+	Sync(ctx context.Context, opts SyncOptions) (*SyncResult, error)
+
+	// Undo restores the repositories of a recorded run to their state before the run.
+	// This is useful for backing out a bulk pull, switch or reset that went wrong.
+	Undo(ctx context.Context, opts UndoOptions) (*UndoResult, error)
+
 	// IsRepository checks if the path points to a valid Git repository.
 	// Returns true if the path contains a .git directory or is a bare repository.
 	IsRepository(ctx context.Context, path string) bool
@@ -66,6 +114,10 @@ type Client interface {
 	// GetStatus retrieves the current working tree status.
 	// This shows modified, staged, untracked files, etc.
 	GetStatus(ctx context.Context, repo *Repository) (*Status, error)
+
+	// PartialClone reads the promisor remote and filter of a partial clone.
+	// Returns nil if the repository is a full clone.
+	PartialClone(ctx context.Context, repo *Repository) (*PartialClone, error)
 }
 
 // Logger provides a logging interface for library consumers.
@@ -216,6 +268,17 @@ type CloneOptions struct {
 	// Recursive clones submodules recursively.
 	Recursive bool
 
+	// Filter creates a partial clone that omits objects until they are needed:
+	// "blob:none" (no file contents), "blob:limit=<size>" (no files larger than
+	// size, e.g. "1m") or "tree:<depth>" ("tree:0": commits only).
+	// Omitted objects are fetched from the remote on demand.
+	Filter string
+
+	// SparsePaths enables a cone-mode sparse checkout limited to these
+	// directories (files in the repository root are always checked out).
+	// Not supported with Bare or Mirror.
+	SparsePaths []string
+
 	// Bare creates a bare repository (no working directory).
 	Bare bool
 
@@ -271,6 +334,20 @@ func WithRecursive() CloneOption {
 	}
 }
 
+// WithFilter creates a partial clone with the given object filter (e.g. "blob:none").
+func WithFilter(filter string) CloneOption {
+	return func(opts *CloneOptions) {
+		opts.Filter = filter
+	}
+}
+
+// WithSparsePaths enables a cone-mode sparse checkout of the given directories.
+func WithSparsePaths(paths ...string) CloneOption {
+	return func(opts *CloneOptions) {
+		opts.SparsePaths = paths
+	}
+}
+
 // WithProgress sets a progress reporter for the clone operation.
 func WithProgress(progress ProgressReporter) CloneOption {
 	return func(opts *CloneOptions) {
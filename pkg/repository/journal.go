@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// runJournalVersion is bumped whenever the on-disk run format changes.
+// Runs with a different version are ignored.
+const runJournalVersion = 1
+
+// runJournalLimit is the number of runs kept; older runs are removed on save.
+const runJournalLimit = 100
+
+// ErrRunNotFound is returned when no matching run is recorded in the journal.
+var ErrRunNotFound = errors.New("run not found")
+
+// Run is the persisted result of a single bulk run.
+type Run struct {
+	// Version is the on-disk format version
+	Version int `json:"version"`
+
+	// ID identifies the run; IDs sort in creation order
+	ID string `json:"id"`
+
+	// Operation is the bulk operation name, as in Report.Operation
+	Operation string `json:"operation"`
+
+	// Directory is the absolute directory the run scanned
+	Directory string `json:"directory"`
+
+	// CreatedAt is when the run was recorded
+	CreatedAt time.Time `json:"created_at"`
+
+	// DurationMs is how long the run took in milliseconds
+	DurationMs int64 `json:"duration_ms"`
+
+	// Summary counts repositories by status
+	Summary map[string]int `json:"summary"`
+
+	// Repositories holds the result of every processed repository
+	Repositories []RunRepository `json:"repositories"`
+}
+
+// RunRepository is the persisted result of one repository in a run.
+type RunRepository struct {
+	RecordBase
+
+	// Undo is the state before the run, for operations that can be undone
+	Undo *UndoState `json:"undo,omitempty"`
+}
+
+// undoRecord is implemented by records of operations that can be undone.
+type undoRecord interface {
+	undoState() *UndoState
+}
+
+// NewRun creates a run from the report of a bulk operation over directory.
+func NewRun(directory string, report *Report) (*Run, error) {
+	absDir, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+
+	run := &Run{
+		Version:      runJournalVersion,
+		Operation:    report.Operation,
+		Directory:    absDir,
+		CreatedAt:    time.Now(),
+		DurationMs:   report.DurationMs,
+		Summary:      report.Summary,
+		Repositories: make([]RunRepository, 0, len(report.Repositories)),
+	}
+	for _, record := range report.Repositories {
+		repo := RunRepository{RecordBase: record.recordBase()}
+		if r, ok := record.(undoRecord); ok && r.undoState().changed() {
+			repo.Undo = r.undoState()
+		}
+		run.Repositories = append(run.Repositories, repo)
+	}
+
+	return run, nil
+}
+
+// NewRepositoryRun creates a run of an operation on a single repository,
+// such as CloneOrUpdate, so that it can be undone.
+func NewRepositoryRun(operation, repoPath, status, message string, undo *UndoState) (*Run, error) {
+	absPath, err := filepath.Abs(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+
+	return &Run{
+		Version:   runJournalVersion,
+		Operation: operation,
+		Directory: absPath,
+		CreatedAt: time.Now(),
+		Summary:   map[string]int{status: 1},
+		Repositories: []RunRepository{{
+			RecordBase: RecordBase{Path: absPath, RelativePath: ".", Status: status, Message: message},
+			Undo:       undo,
+		}},
+	}, nil
+}
+
+// Undoable reports whether any repository of the run can be undone.
+func (r *Run) Undoable() bool {
+	for _, repo := range r.Repositories {
+		if repo.Undo != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// PathsWithStatus returns the paths of repositories that ended with any of statuses.
+func (r *Run) PathsWithStatus(statuses ...string) []string {
+	wanted := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		wanted[status] = true
+	}
+
+	paths := []string{}
+	for _, repo := range r.Repositories {
+		if wanted[repo.Status] {
+			paths = append(paths, repo.Path)
+		}
+	}
+	return paths
+}
+
+// RunJournal stores bulk runs on disk, one JSON file per run.
+type RunJournal struct {
+	dir string
+}
+
+// DefaultRunJournalDir returns the default run journal directory
+// (gz-git/runs in the user cache directory).
+func DefaultRunJournalDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine cache directory: %w", err)
+	}
+	return filepath.Join(cacheDir, "gz-git", "runs"), nil
+}
+
+// NewRunJournal opens the run journal in dir, or in DefaultRunJournalDir() if dir is empty.
+// The directory is created when the first run is saved.
+func NewRunJournal(dir string) (*RunJournal, error) {
+	if dir == "" {
+		defaultDir, err := DefaultRunJournalDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = defaultDir
+	}
+	return &RunJournal{dir: dir}, nil
+}
+
+// Save writes run to the journal, assigning an ID if it has none,
+// and removes the oldest runs beyond the journal limit.
+func (j *RunJournal) Save(run *Run) error {
+	if run.ID == "" {
+		id, err := newRunID(run.CreatedAt)
+		if err != nil {
+			return err
+		}
+		run.ID = id
+	}
+	run.Version = runJournalVersion
+
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode run: %w", err)
+	}
+
+	if err := os.MkdirAll(j.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create run journal directory: %w", err)
+	}
+
+	// Write atomically so a concurrent reader never sees a partial file
+	tmp, err := os.CreateTemp(j.dir, ".run-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write run: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write run: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write run: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), j.runPath(run.ID)); err != nil {
+		return fmt.Errorf("failed to write run: %w", err)
+	}
+
+	j.prune()
+	return nil
+}
+
+// Load reads the run with the given ID.
+func (j *RunJournal) Load(id string) (*Run, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) || strings.HasPrefix(id, ".") {
+		return nil, fmt.Errorf("invalid run id: %q", id)
+	}
+
+	run, err := readRun(j.runPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRunNotFound, id)
+		}
+		return nil, err
+	}
+	return run, nil
+}
+
+// List returns all recorded runs, newest first.
+// Unreadable or incompatible run files are skipped.
+func (j *RunJournal) List() ([]*Run, error) {
+	entries, err := os.ReadDir(j.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*Run{}, nil
+		}
+		return nil, fmt.Errorf("failed to read run journal: %w", err)
+	}
+
+	runs := make([]*Run, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != ".json" {
+			continue
+		}
+		run, err := readRun(filepath.Join(j.dir, name))
+		if err != nil {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	sort.Slice(runs, func(a, b int) bool {
+		if !runs[a].CreatedAt.Equal(runs[b].CreatedAt) {
+			return runs[a].CreatedAt.After(runs[b].CreatedAt)
+		}
+		return runs[a].ID > runs[b].ID
+	})
+	return runs, nil
+}
+
+// Last returns the most recent run of operation over directory.
+func (j *RunJournal) Last(operation, directory string) (*Run, error) {
+	absDir, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+
+	runs, err := j.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if run.Operation == operation && run.Directory == absDir {
+			return run, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no %s run recorded for %s", ErrRunNotFound, operation, absDir)
+}
+
+// LastUndoable returns the most recent run that can be undone.
+func (j *RunJournal) LastUndoable() (*Run, error) {
+	runs, err := j.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if run.Undoable() {
+			return run, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no run that can be undone", ErrRunNotFound)
+}
+
+// runPath returns the file path of the run with the given ID.
+func (j *RunJournal) runPath(id string) string {
+	return filepath.Join(j.dir, id+".json")
+}
+
+// prune removes the oldest runs beyond runJournalLimit.
+// Pruning is best-effort; failures leave extra runs behind.
+func (j *RunJournal) prune() {
+	runs, err := j.List()
+	if err != nil || len(runs) <= runJournalLimit {
+		return
+	}
+	for _, run := range runs[runJournalLimit:] {
+		_ = os.Remove(j.runPath(run.ID))
+	}
+}
+
+// readRun reads and decodes a run file.
+func readRun(path string) (*Run, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var run Run
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, fmt.Errorf("corrupt run file %s: %w", path, err)
+	}
+	if run.Version != runJournalVersion {
+		return nil, fmt.Errorf("unsupported run file version %d: %s", run.Version, path)
+	}
+	return &run, nil
+}
+
+// newRunID returns a sortable, unique run ID such as 20240131-154502-a1b2c3.
+func newRunID(createdAt time.Time) (string, error) {
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate run id: %w", err)
+	}
+	return createdAt.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix), nil
+}
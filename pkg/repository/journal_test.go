@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRunJournal(t *testing.T) {
+	journal, err := NewRunJournal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewRunJournal failed: %v", err)
+	}
+
+	report := newReport("pull", 3, 3, time.Second, map[string]int{StatusPulled: 1, StatusError: 2}, pullRecordColumns, []Record{
+		RepositoryPullResult{Path: "/work/api", RelativePath: "api", Status: StatusPulled}.Record(),
+		RepositoryPullResult{Path: "/work/web", RelativePath: "web", Status: StatusError, Error: errors.New("could not resolve host")}.Record(),
+		RepositoryPullResult{Path: "/work/docs", RelativePath: "docs", Status: StatusError}.Record(),
+	})
+
+	older, err := NewRun("/work", report)
+	if err != nil {
+		t.Fatalf("NewRun failed: %v", err)
+	}
+	older.CreatedAt = time.Now().Add(-time.Hour)
+	if err := journal.Save(older); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	newer, _ := NewRun("/work", report)
+	newer.Repositories = newer.Repositories[:2]
+	if err := journal.Save(newer); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	other, _ := NewRun("/other", report)
+	if err := journal.Save(other); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// Last picks the newest run of the same operation and directory
+	last, err := journal.Last("pull", "/work")
+	if err != nil {
+		t.Fatalf("Last failed: %v", err)
+	}
+	if last.ID != newer.ID {
+		t.Errorf("Last = %s, want %s", last.ID, newer.ID)
+	}
+	if got := last.PathsWithStatus(StatusError); len(got) != 1 || got[0] != "/work/web" {
+		t.Errorf("PathsWithStatus(error) = %v, want [/work/web]", got)
+	}
+	if got := last.Repositories[1].Error; got != "could not resolve host" {
+		t.Errorf("Error = %q, want it persisted", got)
+	}
+
+	if _, err := journal.Last("push", "/work"); !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("Last(push) error = %v, want ErrRunNotFound", err)
+	}
+
+	loaded, err := journal.Load(older.ID)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(loaded.Repositories) != 3 || loaded.Summary[StatusError] != 2 {
+		t.Errorf("unexpected loaded run: %+v", loaded)
+	}
+	if _, err := journal.Load("../escape"); err == nil {
+		t.Error("Load should reject path-like ids")
+	}
+	if _, err := journal.Load("20000101-000000-000000"); !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("Load(missing) error = %v, want ErrRunNotFound", err)
+	}
+
+	runs, err := journal.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(runs) != 3 || runs[len(runs)-1].ID != older.ID {
+		t.Errorf("List should return 3 runs, oldest last, got %d", len(runs))
+	}
+}
+
+func TestRunJournalPrune(t *testing.T) {
+	dir := t.TempDir()
+	journal, _ := NewRunJournal(dir)
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < runJournalLimit+2; i++ {
+		run := &Run{Operation: "fetch", Directory: "/work", CreatedAt: start.Add(time.Duration(i) * time.Second)}
+		run.ID = fmt.Sprintf("run-%03d", i)
+		if err := journal.Save(run); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	runs, _ := journal.List()
+	if len(runs) != runJournalLimit {
+		t.Fatalf("expected %d runs after pruning, got %d", runJournalLimit, len(runs))
+	}
+	if _, err := os.Stat(filepath.Join(dir, "run-000.json")); !os.IsNotExist(err) {
+		t.Error("expected the oldest run to be pruned")
+	}
+}
+
+func TestBulkOnlyRepositories(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, name := range []string{"api", "web", "docs"} {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+		if err := initGitRepo(path); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	result, err := NewClient().BulkExec(context.Background(), BulkExecOptions{
+		Directory:        tmpDir,
+		Args:             []string{"status", "--short"},
+		OnlyRepositories: []string{filepath.Join(tmpDir, "web"), filepath.Join(tmpDir, "gone")},
+	})
+	if err != nil {
+		t.Fatalf("BulkExec failed: %v", err)
+	}
+	if result.TotalScanned != 3 || len(result.Repositories) != 1 || result.Repositories[0].RelativePath != "web" {
+		t.Errorf("expected only web to be processed, got %+v", result.Repositories)
+	}
+}
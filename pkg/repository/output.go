@@ -0,0 +1,1006 @@
+package repository
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Machine-readable output formats supported by WriteReport.
+const (
+	// OutputFormatJSON writes a single JSON document containing the whole report.
+	OutputFormatJSON = "json"
+
+	// OutputFormatNDJSON writes one JSON record per line.
+	OutputFormatNDJSON = "ndjson"
+
+	// OutputFormatCSV writes a header row followed by one row per repository.
+	OutputFormatCSV = "csv"
+)
+
+// Record is the machine-readable form of a single repository result.
+// Every record type embeds RecordBase, so the base fields are always present
+// and always come first, in both JSON and CSV output.
+type Record interface {
+	csvRow() []string
+	recordBase() RecordBase
+}
+
+// RecordBase contains the fields shared by all repository records.
+type RecordBase struct {
+	Path         string `json:"path"`
+	RelativePath string `json:"relative_path"`
+	Status       string `json:"status"`
+	Message      string `json:"message"`
+	Error        string `json:"error"`
+	DurationMs   int64  `json:"duration_ms"`
+}
+
+// recordBaseColumns are the CSV columns of RecordBase.
+var recordBaseColumns = []string{"path", "relative_path", "status", "message", "error", "duration_ms"}
+
+// NewRecordBase creates the base fields of a record. Packages that run their
+// own bulk operations (e.g. commit) use it to build records.
+func NewRecordBase(path, relativePath, status, message string, err error, duration time.Duration) RecordBase {
+	base := RecordBase{
+		Path:         path,
+		RelativePath: relativePath,
+		Status:       status,
+		Message:      message,
+		DurationMs:   duration.Milliseconds(),
+	}
+	if err != nil {
+		base.Error = err.Error()
+	}
+	return base
+}
+
+func (b RecordBase) recordBase() RecordBase {
+	return b
+}
+
+func (b RecordBase) csvRow() []string {
+	return []string{b.Path, b.RelativePath, b.Status, b.Message, b.Error, strconv.FormatInt(b.DurationMs, 10)}
+}
+
+// Report is the machine-readable form of a bulk operation result.
+type Report struct {
+	// Operation is the bulk operation name (fetch, pull, push, status, update, switch, clone, sync, exec,
+	// stash, tag, remote, maintain, doctor, incoming, backup, commit, cleanup)
+	Operation string `json:"operation"`
+
+	// TotalScanned is the number of repositories found (or requested, for clone and sync)
+	TotalScanned int `json:"total_scanned"`
+
+	// TotalProcessed is the number of repositories processed
+	TotalProcessed int `json:"total_processed"`
+
+	// DurationMs is the total operation time in milliseconds
+	DurationMs int64 `json:"duration_ms"`
+
+	// Summary contains status counts
+	Summary map[string]int `json:"summary"`
+
+	// Repositories contains one record per repository
+	Repositories []Record `json:"repositories"`
+
+	// columns are the CSV columns of the report's record type
+	columns []string
+}
+
+// WriteReport writes a report in the given machine-readable format.
+func WriteReport(w io.Writer, format string, report *Report) error {
+	switch format {
+	case OutputFormatJSON:
+		return WriteJSON(w, report)
+	case OutputFormatNDJSON:
+		for _, record := range report.Repositories {
+			if err := WriteNDJSON(w, record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OutputFormatCSV:
+		return WriteCSV(w, report)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func WriteJSON(w io.Writer, report *Report) error {
+	if report.Summary == nil {
+		report.Summary = map[string]int{}
+	}
+	if report.Repositories == nil {
+		report.Repositories = []Record{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteNDJSON writes a single record as one line of JSON.
+// It is typically called from a ResultCallback to stream results as they complete.
+func WriteNDJSON(w io.Writer, record Record) error {
+	if err := json.NewEncoder(w).Encode(record); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the report's records as CSV with a header row.
+func WriteCSV(w io.Writer, report *Report) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(report.columns); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, record := range report.Repositories {
+		if err := writer.Write(record.csvRow()); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// newReport builds a report from converted records.
+func newReport(operation string, scanned, processed int, duration time.Duration, summary map[string]int, columns []string, records []Record) *Report {
+	return &Report{
+		Operation:      operation,
+		TotalScanned:   scanned,
+		TotalProcessed: processed,
+		DurationMs:     duration.Milliseconds(),
+		Summary:        summary,
+		Repositories:   records,
+		columns:        append(append([]string{}, recordBaseColumns...), columns...),
+	}
+}
+
+func itoa(n int) string {
+	return strconv.Itoa(n)
+}
+
+func btoa(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// ShortCommit abbreviates a commit hash to 7 characters for display.
+func ShortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
+
+// FormatSize formats a size in bytes with a binary unit, e.g. "1.5 GiB".
+func FormatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	value := float64(bytes) / unit
+	units := []string{"KiB", "MiB", "GiB", "TiB"}
+	i := 0
+	for value >= unit && i < len(units)-1 {
+		value /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", value, units[i])
+}
+
+// PluralSuffix returns singular if n is 1 and plural otherwise.
+func PluralSuffix(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+// ============================================================================
+// Fetch
+// ============================================================================
+
+// FetchRecord is the machine-readable form of a RepositoryFetchResult.
+type FetchRecord struct {
+	RecordBase
+	Branch         string `json:"branch"`
+	RemoteURL      string `json:"remote_url"`
+	FetchedRefs    int    `json:"fetched_refs"`
+	FetchedObjects int    `json:"fetched_objects"`
+	CommitsBehind  int    `json:"commits_behind"`
+	CommitsAhead   int    `json:"commits_ahead"`
+	Attempts       int    `json:"attempts"`
+}
+
+var fetchRecordColumns = []string{"branch", "remote_url", "fetched_refs", "fetched_objects", "commits_behind", "commits_ahead", "attempts"}
+
+func (r FetchRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.RemoteURL, itoa(r.FetchedRefs), itoa(r.FetchedObjects), itoa(r.CommitsBehind), itoa(r.CommitsAhead), itoa(r.Attempts))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryFetchResult) Record() Record {
+	return FetchRecord{
+		RecordBase:     NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:         r.Branch,
+		RemoteURL:      r.RemoteURL,
+		FetchedRefs:    r.FetchedRefs,
+		FetchedObjects: r.FetchedObjects,
+		CommitsBehind:  r.CommitsBehind,
+		CommitsAhead:   r.CommitsAhead,
+		Attempts:       r.Attempts,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkFetchResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("fetch", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, fetchRecordColumns, records)
+}
+
+// ============================================================================
+// Pull
+// ============================================================================
+
+// PullRecord is the machine-readable form of a RepositoryPullResult.
+type PullRecord struct {
+	RecordBase
+	Branch        string `json:"branch"`
+	RemoteURL     string `json:"remote_url"`
+	CommitsBehind int    `json:"commits_behind"`
+	CommitsAhead  int    `json:"commits_ahead"`
+	UpdatedFiles  int    `json:"updated_files"`
+	Stashed       bool   `json:"stashed"`
+	Attempts      int    `json:"attempts"`
+
+	undo *UndoState // persisted in the run journal, not part of the output
+}
+
+var pullRecordColumns = []string{"branch", "remote_url", "commits_behind", "commits_ahead", "updated_files", "stashed", "attempts"}
+
+func (r PullRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.RemoteURL, itoa(r.CommitsBehind), itoa(r.CommitsAhead), itoa(r.UpdatedFiles), btoa(r.Stashed), itoa(r.Attempts))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryPullResult) Record() Record {
+	return PullRecord{
+		RecordBase:    NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:        r.Branch,
+		RemoteURL:     r.RemoteURL,
+		CommitsBehind: r.CommitsBehind,
+		CommitsAhead:  r.CommitsAhead,
+		UpdatedFiles:  r.UpdatedFiles,
+		Stashed:       r.Stashed,
+		Attempts:      r.Attempts,
+		undo:          r.Undo,
+	}
+}
+
+func (r PullRecord) undoState() *UndoState { return r.undo }
+
+// Report returns the machine-readable form of the result.
+func (r *BulkPullResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("pull", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, pullRecordColumns, records)
+}
+
+// ============================================================================
+// Push
+// ============================================================================
+
+// PushRecord is the machine-readable form of a RepositoryPushResult.
+type PushRecord struct {
+	RecordBase
+	Branch        string `json:"branch"`
+	RemoteURL     string `json:"remote_url"`
+	CommitsAhead  int    `json:"commits_ahead"`
+	PushedCommits int    `json:"pushed_commits"`
+	Attempts      int    `json:"attempts"`
+}
+
+var pushRecordColumns = []string{"branch", "remote_url", "commits_ahead", "pushed_commits", "attempts"}
+
+func (r PushRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.RemoteURL, itoa(r.CommitsAhead), itoa(r.PushedCommits), itoa(r.Attempts))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryPushResult) Record() Record {
+	return PushRecord{
+		RecordBase:    NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:        r.Branch,
+		RemoteURL:     r.RemoteURL,
+		CommitsAhead:  r.CommitsAhead,
+		PushedCommits: r.PushedCommits,
+		Attempts:      r.Attempts,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkPushResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("push", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, pushRecordColumns, records)
+}
+
+// ============================================================================
+// Status
+// ============================================================================
+
+// StatusRecord is the machine-readable form of a RepositoryStatusResult.
+// In CSV output, ConflictFiles is joined with ';'.
+type StatusRecord struct {
+	RecordBase
+	Branch           string   `json:"branch"`
+	RemoteURL        string   `json:"remote_url"`
+	CommitsBehind    int      `json:"commits_behind"`
+	CommitsAhead     int      `json:"commits_ahead"`
+	UncommittedFiles int      `json:"uncommitted_files"`
+	UntrackedFiles   int      `json:"untracked_files"`
+	ConflictFiles    []string `json:"conflict_files"`
+	RebaseInProgress bool     `json:"rebase_in_progress"`
+	MergeInProgress  bool     `json:"merge_in_progress"`
+}
+
+var statusRecordColumns = []string{
+	"branch", "remote_url", "commits_behind", "commits_ahead", "uncommitted_files",
+	"untracked_files", "conflict_files", "rebase_in_progress", "merge_in_progress",
+}
+
+func (r StatusRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.RemoteURL, itoa(r.CommitsBehind), itoa(r.CommitsAhead),
+		itoa(r.UncommittedFiles), itoa(r.UntrackedFiles), strings.Join(r.ConflictFiles, ";"),
+		btoa(r.RebaseInProgress), btoa(r.MergeInProgress))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryStatusResult) Record() Record {
+	conflicts := r.ConflictFiles
+	if conflicts == nil {
+		conflicts = []string{}
+	}
+	return StatusRecord{
+		RecordBase:       NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:           r.Branch,
+		RemoteURL:        r.RemoteURL,
+		CommitsBehind:    r.CommitsBehind,
+		CommitsAhead:     r.CommitsAhead,
+		UncommittedFiles: r.UncommittedFiles,
+		UntrackedFiles:   r.UntrackedFiles,
+		ConflictFiles:    conflicts,
+		RebaseInProgress: r.RebaseInProgress,
+		MergeInProgress:  r.MergeInProgress,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkStatusResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("status", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, statusRecordColumns, records)
+}
+
+// ============================================================================
+// Update
+// ============================================================================
+
+// UpdateRecord is the machine-readable form of a RepositoryUpdateResult.
+type UpdateRecord struct {
+	RecordBase
+	Branch                string `json:"branch"`
+	RemoteURL             string `json:"remote_url"`
+	CommitsBehind         int    `json:"commits_behind"`
+	CommitsAhead          int    `json:"commits_ahead"`
+	HasStash              bool   `json:"has_stash"`
+	InMergeState          bool   `json:"in_merge_state"`
+	HasUncommittedChanges bool   `json:"has_uncommitted_changes"`
+}
+
+var updateRecordColumns = []string{
+	"branch", "remote_url", "commits_behind", "commits_ahead",
+	"has_stash", "in_merge_state", "has_uncommitted_changes",
+}
+
+func (r UpdateRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.RemoteURL, itoa(r.CommitsBehind), itoa(r.CommitsAhead),
+		btoa(r.HasStash), btoa(r.InMergeState), btoa(r.HasUncommittedChanges))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryUpdateResult) Record() Record {
+	return UpdateRecord{
+		RecordBase:            NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:                r.Branch,
+		RemoteURL:             r.RemoteURL,
+		CommitsBehind:         r.CommitsBehind,
+		CommitsAhead:          r.CommitsAhead,
+		HasStash:              r.HasStash,
+		InMergeState:          r.InMergeState,
+		HasUncommittedChanges: r.HasUncommittedChanges,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkUpdateResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("update", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, updateRecordColumns, records)
+}
+
+// ============================================================================
+// Switch
+// ============================================================================
+
+// SwitchRecord is the machine-readable form of a RepositorySwitchResult.
+type SwitchRecord struct {
+	RecordBase
+	PreviousBranch        string `json:"previous_branch"`
+	CurrentBranch         string `json:"current_branch"`
+	RemoteURL             string `json:"remote_url"`
+	HasUncommittedChanges bool   `json:"has_uncommitted_changes"`
+
+	undo *UndoState // persisted in the run journal, not part of the output
+}
+
+var switchRecordColumns = []string{"previous_branch", "current_branch", "remote_url", "has_uncommitted_changes"}
+
+func (r SwitchRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.PreviousBranch, r.CurrentBranch, r.RemoteURL, btoa(r.HasUncommittedChanges))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositorySwitchResult) Record() Record {
+	return SwitchRecord{
+		RecordBase:            NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		PreviousBranch:        r.PreviousBranch,
+		CurrentBranch:         r.CurrentBranch,
+		RemoteURL:             r.RemoteURL,
+		HasUncommittedChanges: r.HasUncommittedChanges,
+		undo:                  r.Undo,
+	}
+}
+
+func (r SwitchRecord) undoState() *UndoState { return r.undo }
+
+// Report returns the machine-readable form of the result.
+func (r *BulkSwitchResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("switch", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, switchRecordColumns, records)
+}
+
+// ============================================================================
+// Clone
+// ============================================================================
+
+// CloneRecord is the machine-readable form of a RepositoryCloneResult.
+type CloneRecord struct {
+	RecordBase
+	URL string `json:"url"`
+}
+
+var cloneRecordColumns = []string{"url"}
+
+func (r CloneRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.URL)
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryCloneResult) Record() Record {
+	return CloneRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		URL:        r.URL,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkCloneResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("clone", r.TotalRequested, r.TotalProcessed, r.Duration, r.Summary, cloneRecordColumns, records)
+}
+
+// ============================================================================
+// Sync
+// ============================================================================
+
+// SyncRecord is the machine-readable form of a RepositorySyncResult.
+type SyncRecord struct {
+	RecordBase
+	URL      string `json:"url"`
+	Branch   string `json:"branch"`
+	Strategy string `json:"strategy"`
+	Action   string `json:"action"`
+
+	undo *UndoState // persisted in the run journal, not part of the output
+}
+
+var syncRecordColumns = []string{"url", "branch", "strategy", "action"}
+
+func (r SyncRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.URL, r.Branch, r.Strategy, r.Action)
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositorySyncResult) Record() Record {
+	return SyncRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		URL:        r.URL,
+		Branch:     r.Branch,
+		Strategy:   string(r.Strategy),
+		Action:     r.Action,
+		undo:       r.Undo,
+	}
+}
+
+func (r SyncRecord) undoState() *UndoState { return r.undo }
+
+// Report returns the machine-readable form of the result.
+func (r *SyncResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("sync", len(r.Repositories), r.TotalProcessed, r.Duration, r.Summary, syncRecordColumns, records)
+}
+
+// ============================================================================
+// Exec
+// ============================================================================
+
+// ExecRecord is the machine-readable form of a RepositoryExecResult.
+type ExecRecord struct {
+	RecordBase
+	ExitCode int    `json:"exit_code"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+}
+
+var execRecordColumns = []string{"exit_code", "stdout", "stderr"}
+
+func (r ExecRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), itoa(r.ExitCode), r.Stdout, r.Stderr)
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryExecResult) Record() Record {
+	return ExecRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		ExitCode:   r.ExitCode,
+		Stdout:     r.Stdout,
+		Stderr:     r.Stderr,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkExecResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("exec", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, execRecordColumns, records)
+}
+
+// ============================================================================
+// Stash
+// ============================================================================
+
+// StashRecord is the machine-readable form of a RepositoryStashResult.
+// Stashes lists the remaining matching entries as "<ref>: <message>";
+// in CSV output, it is joined with ';'.
+type StashRecord struct {
+	RecordBase
+	Branch       string   `json:"branch"`
+	StashRef     string   `json:"stash_ref"`
+	StashMessage string   `json:"stash_message"`
+	CreatedBy    string   `json:"created_by"`
+	StashCount   int      `json:"stash_count"`
+	Stashes      []string `json:"stashes"`
+}
+
+var stashRecordColumns = []string{"branch", "stash_ref", "stash_message", "created_by", "stash_count", "stashes"}
+
+func (r StashRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.StashRef, r.StashMessage, r.CreatedBy,
+		itoa(r.StashCount), strings.Join(r.Stashes, ";"))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryStashResult) Record() Record {
+	record := StashRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:     r.Branch,
+		StashCount: len(r.Stashes),
+		Stashes:    make([]string, len(r.Stashes)),
+	}
+	if r.Entry != nil {
+		record.StashRef = r.Entry.Ref
+		record.StashMessage = r.Entry.Message
+		record.CreatedBy = r.Entry.CreatedBy
+	}
+	for i, entry := range r.Stashes {
+		record.Stashes[i] = entry.Ref + ": " + entry.Message
+	}
+	return record
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkStashResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("stash", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, stashRecordColumns, records)
+}
+
+// ============================================================================
+// Tag
+// ============================================================================
+
+// TagRecord is the machine-readable form of a RepositoryTagResult.
+type TagRecord struct {
+	RecordBase
+	Branch  string `json:"branch"`
+	Commit  string `json:"commit"`
+	Created bool   `json:"created"`
+	Pushed  bool   `json:"pushed"`
+}
+
+var tagRecordColumns = []string{"branch", "commit", "created", "pushed"}
+
+func (r TagRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, r.Commit, btoa(r.Created), btoa(r.Pushed))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryTagResult) Record() Record {
+	return TagRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:     r.Branch,
+		Commit:     r.Commit,
+		Created:    r.Created,
+		Pushed:     r.Pushed,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkTagResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("tag", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, tagRecordColumns, records)
+}
+
+// ============================================================================
+// Remote
+// ============================================================================
+
+// RemoteRecord is the machine-readable form of a RepositoryRemoteSetURLResult.
+type RemoteRecord struct {
+	RecordBase
+	OldURL   string `json:"old_url"`
+	NewURL   string `json:"new_url"`
+	Verified bool   `json:"verified"`
+}
+
+var remoteRecordColumns = []string{"old_url", "new_url", "verified"}
+
+func (r RemoteRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.OldURL, r.NewURL, btoa(r.Verified))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryRemoteSetURLResult) Record() Record {
+	return RemoteRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		OldURL:     r.OldURL,
+		NewURL:     r.NewURL,
+		Verified:   r.Verified,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkRemoteSetURLResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("remote", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, remoteRecordColumns, records)
+}
+
+// ============================================================================
+// Maintain
+// ============================================================================
+
+// MaintainRecord is the machine-readable form of a RepositoryMaintainResult.
+// Sizes are in bytes; in CSV output, Tasks is joined with ';'.
+type MaintainRecord struct {
+	RecordBase
+	Tasks              []string `json:"tasks"`
+	SizeBefore         int64    `json:"size_before"`
+	SizeAfter          int64    `json:"size_after"`
+	LooseObjectsBefore int      `json:"loose_objects_before"`
+	LooseObjectsAfter  int      `json:"loose_objects_after"`
+	PacksBefore        int      `json:"packs_before"`
+	PacksAfter         int      `json:"packs_after"`
+}
+
+var maintainRecordColumns = []string{"tasks", "size_before", "size_after", "loose_objects_before", "loose_objects_after", "packs_before", "packs_after"}
+
+func (r MaintainRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), strings.Join(r.Tasks, ";"),
+		strconv.FormatInt(r.SizeBefore, 10), strconv.FormatInt(r.SizeAfter, 10),
+		itoa(r.LooseObjectsBefore), itoa(r.LooseObjectsAfter), itoa(r.PacksBefore), itoa(r.PacksAfter))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryMaintainResult) Record() Record {
+	return MaintainRecord{
+		RecordBase:         NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Tasks:              maintenanceTaskNames(r.Tasks),
+		SizeBefore:         r.Before.Size,
+		SizeAfter:          r.After.Size,
+		LooseObjectsBefore: r.Before.LooseObjects,
+		LooseObjectsAfter:  r.After.LooseObjects,
+		PacksBefore:        r.Before.Packs,
+		PacksAfter:         r.After.Packs,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkMaintainResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("maintain", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, maintainRecordColumns, records)
+}
+
+// ============================================================================
+// Doctor
+// ============================================================================
+
+// DoctorRecord is the machine-readable form of a RepositoryDoctorResult.
+// Issues lists the open issues as "<check>: <message>" and Fixed the checks
+// whose issue was fixed; in CSV output, both are joined with ';'.
+type DoctorRecord struct {
+	RecordBase
+	Branch string   `json:"branch"`
+	Issues []string `json:"issues"`
+	Fixed  []string `json:"fixed"`
+}
+
+var doctorRecordColumns = []string{"branch", "issues", "fixed"}
+
+func (r DoctorRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Branch, strings.Join(r.Issues, ";"), strings.Join(r.Fixed, ";"))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryDoctorResult) Record() Record {
+	record := DoctorRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:     r.Branch,
+		Issues:     []string{},
+		Fixed:      []string{},
+	}
+	for _, issue := range r.Issues {
+		if issue.Fixed {
+			record.Fixed = append(record.Fixed, string(issue.Check))
+		} else {
+			record.Issues = append(record.Issues, fmt.Sprintf("%s: %s", issue.Check, issue.Message))
+		}
+	}
+	return record
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkDoctorResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("doctor", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, doctorRecordColumns, records)
+}
+
+// ============================================================================
+// Incoming
+// ============================================================================
+
+// IncomingRecord is the machine-readable form of a RepositoryIncomingResult.
+// In CSV output, Commits is written as "<short hash> <subject>" joined with ';'.
+type IncomingRecord struct {
+	RecordBase
+	Branch        string                 `json:"branch"`
+	Upstream      string                 `json:"upstream"`
+	CommitsBehind int                    `json:"commits_behind"`
+	CommitsAhead  int                    `json:"commits_ahead"`
+	Commits       []IncomingCommitRecord `json:"commits"`
+}
+
+// IncomingCommitRecord is the machine-readable form of an IncomingCommit.
+type IncomingCommitRecord struct {
+	Hash    string   `json:"hash"`
+	Author  string   `json:"author"`
+	Date    string   `json:"date"`
+	Subject string   `json:"subject"`
+	Files   []string `json:"files"`
+}
+
+var incomingRecordColumns = []string{"branch", "upstream", "commits_behind", "commits_ahead", "commits"}
+
+func (r IncomingRecord) csvRow() []string {
+	commits := make([]string, len(r.Commits))
+	for i, commit := range r.Commits {
+		hash := commit.Hash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		commits[i] = hash + " " + commit.Subject
+	}
+	return append(r.RecordBase.csvRow(), r.Branch, r.Upstream, itoa(r.CommitsBehind), itoa(r.CommitsAhead),
+		strings.Join(commits, ";"))
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryIncomingResult) Record() Record {
+	record := IncomingRecord{
+		RecordBase:    NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		Branch:        r.Branch,
+		Upstream:      r.Upstream,
+		CommitsBehind: r.CommitsBehind,
+		CommitsAhead:  r.CommitsAhead,
+		Commits:       make([]IncomingCommitRecord, len(r.Commits)),
+	}
+	for i, commit := range r.Commits {
+		record.Commits[i] = IncomingCommitRecord{
+			Hash:    commit.Hash,
+			Author:  commit.Author,
+			Date:    commit.Date.Format(time.RFC3339),
+			Subject: commit.Subject,
+			Files:   commit.Files,
+		}
+		if record.Commits[i].Files == nil {
+			record.Commits[i].Files = []string{}
+		}
+	}
+	return record
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkIncomingResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("incoming", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, incomingRecordColumns, records)
+}
+
+// ============================================================================
+// Backup
+// ============================================================================
+
+// BackupRecord is the machine-readable form of a RepositoryBackupResult.
+type BackupRecord struct {
+	RecordBase
+	RemoteURL  string `json:"remote_url"`
+	MirrorPath string `json:"mirror_path"`
+	BundlePath string `json:"bundle_path"`
+}
+
+var backupRecordColumns = []string{"remote_url", "mirror_path", "bundle_path"}
+
+func (r BackupRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.RemoteURL, r.MirrorPath, r.BundlePath)
+}
+
+// Record returns the machine-readable form of the result.
+func (r RepositoryBackupResult) Record() Record {
+	return BackupRecord{
+		RecordBase: NewRecordBase(r.Path, r.RelativePath, r.Status, r.Message, r.Error, r.Duration),
+		RemoteURL:  r.RemoteURL,
+		MirrorPath: r.MirrorPath,
+		BundlePath: r.BundlePath,
+	}
+}
+
+// Report returns the machine-readable form of the result.
+func (r *BulkBackupResult) Report() *Report {
+	records := make([]Record, len(r.Repositories))
+	for i, repo := range r.Repositories {
+		records[i] = repo.Record()
+	}
+	return newReport("backup", r.TotalScanned, r.TotalProcessed, r.Duration, r.Summary, backupRecordColumns, records)
+}
+
+// ============================================================================
+// Commit
+// ============================================================================
+
+// CommitRecord is the machine-readable form of a multi commit result
+// (see commit.RepositoryCommitResult).
+type CommitRecord struct {
+	RecordBase
+	Commit        string `json:"commit"`
+	CommitMessage string `json:"commit_message"`
+	Files         int    `json:"files"`
+}
+
+var commitRecordColumns = []string{"commit", "commit_message", "files"}
+
+func (r CommitRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(), r.Commit, r.CommitMessage, itoa(r.Files))
+}
+
+// NewCommitReport returns the machine-readable form of a multi commit result.
+func NewCommitReport(scanned, processed int, duration time.Duration, summary map[string]int, records []CommitRecord) *Report {
+	base := make([]Record, len(records))
+	for i, record := range records {
+		base[i] = record
+	}
+	return newReport("commit", scanned, processed, duration, summary, commitRecordColumns, base)
+}
+
+// ============================================================================
+// Cleanup
+// ============================================================================
+
+// CleanupRecord is the machine-readable form of the branch cleanup of a
+// repository (see branch.RepositoryCleanupReport). Branch lists hold branch
+// names; in CSV output, they are joined with ';'.
+type CleanupRecord struct {
+	RecordBase
+	Merged   []string `json:"merged"`
+	Stale    []string `json:"stale"`
+	Orphaned []string `json:"orphaned"`
+	Deleted  []string `json:"deleted"`
+	Failed   []string `json:"failed"`
+}
+
+var cleanupRecordColumns = []string{"merged", "stale", "orphaned", "deleted", "failed"}
+
+func (r CleanupRecord) csvRow() []string {
+	return append(r.RecordBase.csvRow(),
+		strings.Join(r.Merged, ";"), strings.Join(r.Stale, ";"), strings.Join(r.Orphaned, ";"),
+		strings.Join(r.Deleted, ";"), strings.Join(r.Failed, ";"))
+}
+
+// NewCleanupReport returns the machine-readable form of a multi cleanup result.
+func NewCleanupReport(scanned, processed int, duration time.Duration, summary map[string]int, records []CleanupRecord) *Report {
+	base := make([]Record, len(records))
+	for i, record := range records {
+		base[i] = record
+	}
+	return newReport("cleanup", scanned, processed, duration, summary, cleanupRecordColumns, base)
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleFetchResult() *BulkFetchResult {
+	return &BulkFetchResult{
+		TotalScanned:   2,
+		TotalProcessed: 2,
+		Duration:       1500 * time.Millisecond,
+		Summary:        map[string]int{StatusFetched: 1, StatusError: 1},
+		Repositories: []RepositoryFetchResult{
+			{
+				Path:          "/work/api",
+				RelativePath:  "api",
+				Status:        StatusFetched,
+				Message:       "Fetched",
+				Duration:      250 * time.Millisecond,
+				Branch:        "main",
+				RemoteURL:     "https://github.com/user/api.git",
+				CommitsBehind: 3,
+			},
+			{
+				Path:         "/work/web",
+				RelativePath: "web",
+				Status:       StatusError,
+				Message:      "Fetch failed",
+				Error:        errors.New("could not read from remote"),
+				Duration:     time.Second,
+			},
+		},
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, OutputFormatJSON, sampleFetchResult().Report()); err != nil {
+		t.Fatalf("WriteReport failed: %v", err)
+	}
+
+	var decoded struct {
+		Operation      string                   `json:"operation"`
+		TotalScanned   int                      `json:"total_scanned"`
+		DurationMs     int64                    `json:"duration_ms"`
+		Summary        map[string]int           `json:"summary"`
+		Repositories   []map[string]interface{} `json:"repositories"`
+		TotalProcessed int                      `json:"total_processed"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	if decoded.Operation != "fetch" || decoded.TotalScanned != 2 || decoded.DurationMs != 1500 {
+		t.Errorf("unexpected report header: %+v", decoded)
+	}
+	if decoded.Summary[StatusError] != 1 {
+		t.Errorf("unexpected summary: %v", decoded.Summary)
+	}
+	if len(decoded.Repositories) != 2 {
+		t.Fatalf("expected 2 repositories, got %d", len(decoded.Repositories))
+	}
+
+	first := decoded.Repositories[0]
+	if first["relative_path"] != "api" || first["error"] != "" || first["commits_behind"] != float64(3) {
+		t.Errorf("unexpected first record: %v", first)
+	}
+
+	second := decoded.Repositories[1]
+	if second["error"] != "could not read from remote" {
+		t.Errorf("error should be serialized as a string, got %v", second["error"])
+	}
+}
+
+func TestWriteJSONEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	result := &BulkStatusResult{}
+	if err := WriteJSON(&buf, result.Report()); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `"repositories": []`) || !strings.Contains(out, `"summary": {}`) {
+		t.Errorf("empty report should use empty collections, got:\n%s", out)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, OutputFormatNDJSON, sampleFetchResult().Report()); err != nil {
+		t.Fatalf("WriteReport failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d:\n%s", len(lines), buf.String())
+	}
+
+	for _, line := range lines {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Errorf("invalid JSON line %q: %v", line, err)
+		}
+		if _, ok := record["status"]; !ok {
+			t.Errorf("record missing status: %s", line)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, OutputFormatCSV, sampleFetchResult().Report()); err != nil {
+		t.Fatalf("WriteReport failed: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d", len(rows))
+	}
+
+	wantHeader := "path,relative_path,status,message,error,duration_ms,branch,remote_url,fetched_refs,fetched_objects,commits_behind,commits_ahead,attempts"
+	if got := strings.Join(rows[0], ","); got != wantHeader {
+		t.Errorf("header = %q, want %q", got, wantHeader)
+	}
+	for i, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			t.Errorf("row %d has %d columns, want %d", i, len(row), len(rows[0]))
+		}
+	}
+	if rows[2][4] != "could not read from remote" {
+		t.Errorf("error column = %q", rows[2][4])
+	}
+}
+
+func TestWriteCSVEmptyHasHeader(t *testing.T) {
+	var buf bytes.Buffer
+	result := &BulkSwitchResult{}
+	if err := WriteCSV(&buf, result.Report()); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+
+	if got := strings.TrimSpace(buf.String()); !strings.HasPrefix(got, "path,relative_path,status") {
+		t.Errorf("expected header only, got %q", got)
+	}
+}
+
+func TestWriteReportUnsupportedFormat(t *testing.T) {
+	if err := WriteReport(&bytes.Buffer{}, "xml", sampleFetchResult().Report()); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := map[int64]string{
+		512:       "512 B",
+		2048:      "2.0 KiB",
+		300 << 20: "300.0 MiB",
+		3 << 30:   "3.0 GiB",
+	}
+	for size, want := range tests {
+		if got := FormatSize(size); got != want {
+			t.Errorf("FormatSize(%d) = %q, want %q", size, got, want)
+		}
+	}
+}
+
+func TestReportsMatchColumns(t *testing.T) {
+	reports := []*Report{
+		(&BulkFetchResult{Repositories: []RepositoryFetchResult{{}}}).Report(),
+		(&BulkPullResult{Repositories: []RepositoryPullResult{{}}}).Report(),
+		(&BulkPushResult{Repositories: []RepositoryPushResult{{}}}).Report(),
+		(&BulkStatusResult{Repositories: []RepositoryStatusResult{{ConflictFiles: []string{"a", "b"}}}}).Report(),
+		(&BulkUpdateResult{Repositories: []RepositoryUpdateResult{{}}}).Report(),
+		(&BulkSwitchResult{Repositories: []RepositorySwitchResult{{}}}).Report(),
+		(&BulkCloneResult{Repositories: []RepositoryCloneResult{{}}}).Report(),
+		(&SyncResult{Repositories: []RepositorySyncResult{{}}}).Report(),
+		(&BulkExecResult{Repositories: []RepositoryExecResult{{}}}).Report(),
+		(&BulkTagResult{Repositories: []RepositoryTagResult{{}}}).Report(),
+		(&BulkStashResult{Repositories: []RepositoryStashResult{{Stashes: []StashEntry{{Ref: "stash@{0}"}}}}}).Report(),
+		(&BulkRemoteSetURLResult{Repositories: []RepositoryRemoteSetURLResult{{}}}).Report(),
+		(&BulkMaintainResult{Repositories: []RepositoryMaintainResult{{Tasks: []MaintenanceTask{MaintenanceGC}}}}).Report(),
+		(&BulkDoctorResult{Repositories: []RepositoryDoctorResult{{Issues: []HealthIssue{{Check: HealthShallow}, {Check: HealthMissingUpstream, Fixed: true}}}}}).Report(),
+		(&BulkIncomingResult{Repositories: []RepositoryIncomingResult{{Commits: []IncomingCommit{{Hash: "abc"}, {Hash: "def"}}}}}).Report(),
+		(&BulkBackupResult{Repositories: []RepositoryBackupResult{{}}}).Report(),
+		NewCommitReport(1, 1, 0, nil, []CommitRecord{{}}),
+		NewCleanupReport(1, 1, 0, nil, []CleanupRecord{{Merged: []string{"a", "b"}}}),
+	}
+
+	for _, report := range reports {
+		row := report.Repositories[0].csvRow()
+		if len(row) != len(report.columns) {
+			t.Errorf("%s: row has %d columns, header has %d", report.Operation, len(row), len(report.columns))
+		}
+	}
+}
+
+func TestResultCallback(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	if err := os.MkdirAll(repoPath, 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := initGitRepo(repoPath); err != nil {
+		t.Skipf("Skipping test: git not available: %v", err)
+	}
+
+	var called []string
+	client := NewClient()
+	_, err := client.BulkStatus(context.Background(), BulkStatusOptions{
+		Directory: tmpDir,
+		ResultCallback: func(result RepositoryStatusResult) {
+			called = append(called, result.RelativePath)
+		},
+	})
+	if err != nil {
+		t.Fatalf("BulkStatus failed: %v", err)
+	}
+
+	if len(called) != 1 || called[0] != "repo" {
+		t.Errorf("expected callback for repo, got %v", called)
+	}
+}
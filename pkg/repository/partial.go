@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// cloneFilterPattern matches blob:none, blob:limit=<n>[kmg] and tree:<depth>.
+var cloneFilterPattern = regexp.MustCompile(`^(blob:none|blob:limit=[0-9]+[kmgKMG]?|tree:[0-9]+)$`)
+
+// ValidateCloneFilter checks that filter is a supported partial clone filter:
+// "blob:none", "blob:limit=<size>" (e.g. "blob:limit=1m") or "tree:<depth>" (e.g. "tree:0").
+// An empty filter means a full clone and is valid.
+func ValidateCloneFilter(filter string) error {
+	if filter == "" || cloneFilterPattern.MatchString(filter) {
+		return nil
+	}
+	return &ValidationError{
+		Field:  "Filter",
+		Value:  filter,
+		Reason: "unsupported partial clone filter (use blob:none, blob:limit=<size> or tree:<depth>)",
+	}
+}
+
+// PartialClone describes a repository whose missing objects are fetched on
+// demand from a promisor remote.
+type PartialClone struct {
+	// Remote is the promisor remote that omitted objects are fetched from.
+	Remote string
+
+	// Filter is the object filter of the clone (e.g. "blob:none").
+	// Empty for partial clones created by older Git versions.
+	Filter string
+}
+
+// PartialCloneConfigPattern selects the configuration keys read by
+// ParsePartialCloneConfig with git config --get-regexp.
+const PartialCloneConfigPattern = "partialclone"
+
+// ParsePartialCloneConfig reads a partial clone from the output lines of
+// git config --get-regexp PartialCloneConfigPattern.
+// Returns nil for a full clone.
+func ParsePartialCloneConfig(lines []string) *PartialClone {
+	// remote.<name>.partialclonefilter <filter>, or extensions.partialclone <name>
+	// in repositories created by older Git versions
+	var partial PartialClone
+	for _, line := range lines {
+		key, value, _ := strings.Cut(line, " ")
+		switch {
+		case key == "extensions.partialclone":
+			if partial.Remote == "" {
+				partial.Remote = value
+			}
+		case strings.HasPrefix(key, "remote.") && strings.HasSuffix(key, ".partialclonefilter"):
+			partial.Remote = strings.TrimSuffix(strings.TrimPrefix(key, "remote."), ".partialclonefilter")
+			partial.Filter = value
+		}
+	}
+
+	if partial.Remote == "" {
+		return nil
+	}
+	return &partial
+}
+
+// PartialClone reads the promisor remote and filter of a partial clone.
+// Returns nil if the repository is a full clone.
+func (c *client) PartialClone(ctx context.Context, repo *Repository) (*PartialClone, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("repository cannot be nil")
+	}
+
+	// git config exits with 1 when no key matches
+	result, err := c.executor.Run(ctx, repo.Path, "config", "--get-regexp", PartialCloneConfigPattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read partial clone configuration: %w", err)
+	}
+	switch result.ExitCode {
+	case 0:
+	case 1:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to read partial clone configuration: %s", strings.TrimSpace(result.Stderr))
+	}
+
+	return ParsePartialCloneConfig(strings.Split(strings.TrimSpace(result.Stdout), "\n")), nil
+}
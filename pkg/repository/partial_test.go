@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateCloneFilter(t *testing.T) {
+	for _, filter := range []string{"", "blob:none", "blob:limit=1m", "blob:limit=1024", "tree:0", "tree:2"} {
+		if err := ValidateCloneFilter(filter); err != nil {
+			t.Errorf("ValidateCloneFilter(%q) error = %v", filter, err)
+		}
+	}
+
+	for _, filter := range []string{"blob", "blob:some", "blob:limit=", "blob:limit=1x", "tree:", "sparse:oid=abc", "blob:none;rm"} {
+		if err := ValidateCloneFilter(filter); err == nil {
+			t.Errorf("ValidateCloneFilter(%q) expected error", filter)
+		}
+	}
+}
+
+func TestParsePartialCloneConfig(t *testing.T) {
+	tests := []struct {
+		lines []string
+		want  *PartialClone
+	}{
+		{lines: []string{"remote.origin.promisor true", "remote.origin.partialclonefilter blob:none"}, want: &PartialClone{Remote: "origin", Filter: "blob:none"}},
+		{lines: []string{"extensions.partialclone upstream"}, want: &PartialClone{Remote: "upstream"}},
+		{lines: []string{""}, want: nil},
+	}
+
+	for _, tt := range tests {
+		got := ParsePartialCloneConfig(tt.lines)
+		if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+			t.Errorf("ParsePartialCloneConfig(%q) = %+v, want %+v", tt.lines, got, tt.want)
+		}
+	}
+}
+
+func TestClonePartial(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	remote := initSparseRemote(t, tmpDir)
+	gitOutput(t, remote, "config", "uploadpack.allowFilter", "true")
+
+	client := NewClient()
+	repo, err := client.Clone(ctx, CloneOptions{
+		URL:         "file://" + filepath.ToSlash(remote),
+		Destination: filepath.Join(tmpDir, "partial"),
+		Filter:      "blob:none",
+		Quiet:       true,
+	})
+	if err != nil {
+		t.Fatalf("Clone failed: %v", err)
+	}
+	partial, err := client.PartialClone(ctx, repo)
+	if err != nil {
+		t.Fatalf("PartialClone failed: %v", err)
+	}
+	if partial == nil || partial.Remote != "origin" || partial.Filter != "blob:none" {
+		t.Errorf("PartialClone() = %+v, want partial clone from origin with blob:none", partial)
+	}
+
+	// A full clone is not a partial clone
+	full, err := client.Clone(ctx, CloneOptions{URL: remote, Destination: filepath.Join(tmpDir, "full"), Quiet: true})
+	if err != nil {
+		t.Fatalf("Clone failed: %v", err)
+	}
+	if partial, err := client.PartialClone(ctx, full); err != nil || partial != nil {
+		t.Errorf("PartialClone() = %+v, %v, want full clone", partial, err)
+	}
+
+	// Invalid filters are rejected before cloning
+	if _, err := client.Clone(ctx, CloneOptions{URL: remote, Destination: filepath.Join(tmpDir, "invalid"), Filter: "blob:all"}); err == nil {
+		t.Error("Clone() with invalid filter expected error")
+	}
+}
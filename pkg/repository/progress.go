@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"sync"
+	"time"
+)
+
+// ProgressEventKind identifies what a ProgressEvent reports.
+type ProgressEventKind string
+
+const (
+	// ProgressStarted is sent when a worker starts processing a repository,
+	// after waiting for a per-host slot.
+	ProgressStarted ProgressEventKind = "started"
+
+	// ProgressFinished is sent when a repository is done, with its status.
+	ProgressFinished ProgressEventKind = "finished"
+)
+
+// ProgressEvent reports a repository of a bulk operation starting or finishing.
+// Unlike ProgressCallback, which only reports when a repository is picked up,
+// events let callers track which repositories are in flight, how long each one
+// takes and how many finished with each status.
+type ProgressEvent struct {
+	// Kind is what happened (started or finished)
+	Kind ProgressEventKind
+
+	// Repository identifies the repository, as passed to ProgressCallback
+	// (the repository path, or the URL for clones)
+	Repository string
+
+	// Index is the 1-based position of the repository in the operation
+	Index int
+
+	// Total is the number of repositories in the operation
+	Total int
+
+	// Completed is the number of repositories finished so far,
+	// including this one for finished events
+	Completed int
+
+	// Status is the result status (finished events only)
+	Status string
+
+	// Duration is the time spent on the repository (finished events only)
+	Duration time.Duration
+}
+
+// ProgressEvents sends progress events to a callback, serializing the calls
+// and counting finished repositories. A nil ProgressEvents sends nothing.
+type ProgressEvents struct {
+	callback  func(ProgressEvent)
+	total     int
+	mu        sync.Mutex
+	completed int
+}
+
+// NewProgressEvents creates an event sender for an operation on total
+// repositories, or nil if callback is nil.
+func NewProgressEvents(callback func(ProgressEvent), total int) *ProgressEvents {
+	if callback == nil {
+		return nil
+	}
+	return &ProgressEvents{callback: callback, total: total}
+}
+
+// Started reports that the repository at 1-based index started processing.
+func (p *ProgressEvents) Started(index int, repo string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.callback(ProgressEvent{
+		Kind:       ProgressStarted,
+		Repository: repo,
+		Index:      index,
+		Total:      p.total,
+		Completed:  p.completed,
+	})
+}
+
+// Finished reports that the repository at 1-based index finished with status.
+func (p *ProgressEvents) Finished(index int, repo, status string, duration time.Duration) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.completed++
+	p.callback(ProgressEvent{
+		Kind:       ProgressFinished,
+		Repository: repo,
+		Index:      index,
+		Total:      p.total,
+		Completed:  p.completed,
+		Status:     status,
+		Duration:   duration,
+	})
+}
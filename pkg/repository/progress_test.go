@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBulkStatusProgressEvents(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, name := range []string{"a", "b", "c"} {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+		if err := initGitRepoWithCommit(path); err != nil {
+			t.Skipf("Skipping test: git not available: %v", err)
+		}
+	}
+
+	var events []ProgressEvent
+	result, err := NewClient().BulkStatus(context.Background(), BulkStatusOptions{
+		Directory:             tmpDir,
+		Parallel:              2,
+		ProgressEventCallback: func(event ProgressEvent) { events = append(events, event) },
+	})
+	if err != nil {
+		t.Fatalf("BulkStatus failed: %v", err)
+	}
+
+	if len(events) != 2*len(result.Repositories) {
+		t.Fatalf("got %d events, want a started and a finished event for each of %d repositories", len(events), len(result.Repositories))
+	}
+
+	started := make(map[string]bool)
+	completed := 0
+	for _, event := range events {
+		if event.Total != 3 {
+			t.Errorf("event Total = %d, want 3", event.Total)
+		}
+		switch event.Kind {
+		case ProgressStarted:
+			started[event.Repository] = true
+		case ProgressFinished:
+			if !started[event.Repository] {
+				t.Errorf("%s finished before it started", event.Repository)
+			}
+			if event.Status == "" {
+				t.Errorf("%s finished without a status", event.Repository)
+			}
+			completed++
+			if event.Completed != completed {
+				t.Errorf("finished event Completed = %d, want %d", event.Completed, completed)
+			}
+		}
+	}
+	if completed != 3 {
+		t.Errorf("got %d finished events, want 3", completed)
+	}
+}
+
+func TestProgressEventsNil(t *testing.T) {
+	// Operations without a ProgressEventCallback send nothing
+	events := NewProgressEvents(nil, 3)
+	if events != nil {
+		t.Fatal("NewProgressEvents(nil) should return nil")
+	}
+	events.Started(1, "repo")
+	events.Finished(1, "repo", StatusClean, 0)
+}
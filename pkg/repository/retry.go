@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+)
+
+// Default backoff for retrying transient failures
+const (
+	// DefaultRetryBackoff is the delay before the first retry
+	DefaultRetryBackoff = time.Second
+
+	// DefaultRetryMaxBackoff caps the delay between retries
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures retries of transient network failures
+// (DNS failures, timeouts, HTTP 5xx, dropped connections) in bulk fetch, pull and push.
+// Permanent failures such as authentication errors or rejected pushes are never retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt (0: no retries)
+	MaxRetries int
+
+	// Backoff is the delay before the first retry; it doubles for every
+	// further retry (default: DefaultRetryBackoff)
+	Backoff time.Duration
+
+	// MaxBackoff caps the delay between retries (default: DefaultRetryMaxBackoff)
+	MaxBackoff time.Duration
+}
+
+// delay returns the backoff before the given retry (1 for the first retry).
+func (p RetryPolicy) delay(retry int) time.Duration {
+	backoff := p.Backoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+
+	for i := 1; i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// runWithRetry runs a git command, retrying it according to policy while it
+// fails transiently. It returns the result of the last attempt and the number
+// of attempts made. A failed command is reported as a *gitcmd.GitError.
+func (c *client) runWithRetry(ctx context.Context, repoPath string, policy RetryPolicy, logger Logger, args ...string) (*gitcmd.Result, int, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := c.executor.Run(ctx, repoPath, args...)
+		if err != nil {
+			return result, attempt, err
+		}
+		if result.ExitCode == 0 {
+			return result, attempt, nil
+		}
+
+		gitErr := &gitcmd.GitError{
+			Command:  "git " + strings.Join(args, " "),
+			ExitCode: result.ExitCode,
+			Stderr:   strings.TrimSpace(result.Stderr),
+			Cause:    result.Error,
+		}
+		if attempt > policy.MaxRetries || !gitcmd.IsTransient(gitErr) {
+			return result, attempt, gitErr
+		}
+
+		delay := policy.delay(attempt)
+		logger.Warn("transient git failure, retrying", "path", repoPath, "command", args[0], "attempt", attempt, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return result, attempt, gitErr
+		case <-time.After(delay):
+		}
+	}
+}
+
+// gitFailure returns the error reported for a failed git command: its exit code
+// and the first line of its error output. Other errors are returned unchanged.
+func gitFailure(op string, err error) error {
+	var gitErr *gitcmd.GitError
+	if !errors.As(err, &gitErr) {
+		return err
+	}
+	return &gitFailureError{op: op, err: gitErr}
+}
+
+// gitFailureError is a failed git command, summarized for display. The
+// *gitcmd.GitError stays in the chain for the exit code and full stderr.
+type gitFailureError struct {
+	op  string
+	err *gitcmd.GitError
+}
+
+// Error implements the error interface.
+func (e *gitFailureError) Error() string {
+	detail, _, _ := strings.Cut(e.err.Stderr, "\n")
+	if detail == "" && e.err.Cause != nil {
+		detail = e.err.Cause.Error()
+	}
+	return fmt.Sprintf("%s exited with code %d: %s", e.op, e.err.ExitCode, strings.TrimSpace(detail))
+}
+
+// Unwrap implements error unwrapping.
+func (e *gitFailureError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := policy.delay(i + 1); got != w {
+			t.Errorf("delay(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	if got := (RetryPolicy{}).delay(1); got != DefaultRetryBackoff {
+		t.Errorf("default delay = %v, want %v", got, DefaultRetryBackoff)
+	}
+}
+
+// initRepoWithOrigin creates a repository in dir whose origin points at url.
+func initRepoWithOrigin(t *testing.T, dir, url string) {
+	t.Helper()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("Failed to create %s: %v", dir, err)
+	}
+	if err := initGitRepo(dir); err != nil {
+		t.Skipf("Skipping test: git not available: %v", err)
+	}
+	cmd := exec.Command("git", "remote", "add", "origin", url)
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git remote add failed: %v\n%s", err, output)
+	}
+}
+
+func TestBulkFetchRetry(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// .invalid never resolves, so fetching fails with a transient DNS error
+	initRepoWithOrigin(t, filepath.Join(tmpDir, "unreachable"), "https://gz-git-test.invalid/repo.git")
+	// A missing local remote is a permanent failure
+	initRepoWithOrigin(t, filepath.Join(tmpDir, "missing"), filepath.Join(tmpDir, "does-not-exist"))
+
+	result, err := NewClient().BulkFetch(context.Background(), BulkFetchOptions{
+		Directory: tmpDir,
+		Retry:     RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("BulkFetch failed: %v", err)
+	}
+
+	for _, repo := range result.Repositories {
+		if repo.Status != StatusError {
+			t.Fatalf("%s: expected error status, got %s", repo.RelativePath, repo.Status)
+		}
+
+		switch repo.RelativePath {
+		case "unreachable":
+			if !strings.Contains(repo.Error.Error(), "resolve host") {
+				t.Skipf("Skipping test: unexpected fetch failure: %v", repo.Error)
+			}
+			if repo.Attempts != 3 {
+				t.Errorf("unreachable: Attempts = %d, want 3", repo.Attempts)
+			}
+		case "missing":
+			if repo.Attempts != 1 {
+				t.Errorf("missing: Attempts = %d, want 1 (permanent failures are not retried)", repo.Attempts)
+			}
+		}
+	}
+}
+
+func TestBulkFetchNoRetryByDefault(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRepoWithOrigin(t, filepath.Join(tmpDir, "unreachable"), "https://gz-git-test.invalid/repo.git")
+
+	result, err := NewClient().BulkFetch(context.Background(), BulkFetchOptions{Directory: tmpDir})
+	if err != nil {
+		t.Fatalf("BulkFetch failed: %v", err)
+	}
+	if got := result.Repositories[0].Attempts; got != 1 {
+		t.Errorf("Attempts = %d, want 1 without a retry policy", got)
+	}
+
+	// The git error stays reachable for its exit code and stderr
+	var gitErr *gitcmd.GitError
+	if err := result.Repositories[0].Error; !errors.As(err, &gitErr) || gitErr.ExitCode == 0 {
+		t.Errorf("Error = %v, want a wrapped *gitcmd.GitError", err)
+	} else if strings.Contains(err.Error(), "\n") {
+		t.Errorf("Error = %q, want a single line", err)
+	}
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// scanIndexVersion is bumped whenever the on-disk index format changes.
+// Index files with a different version are ignored and rewritten.
+const scanIndexVersion = 1
+
+// scanIndexRacyWindow is how close to the previous scan a directory may have been
+// modified before its cached entry is distrusted. Changes made within the same
+// mtime tick as a scan would otherwise go unnoticed.
+const scanIndexRacyWindow = 2 * time.Second
+
+// ScanIndexOptions configures the persistent repository discovery index.
+//
+// The index caches, per scanned directory, whether it is a repository and which
+// subdirectories it contains. A cached entry is reused only while the directory's
+// modification time is unchanged, so added or removed repositories are picked up
+// on the next scan and only changed directories are read again.
+// Index files are keyed by scan root, maximum depth and submodule setting.
+type ScanIndexOptions struct {
+	// Dir is the directory where index files are stored
+	// (default: DefaultScanIndexDir())
+	Dir string
+
+	// Rescan ignores existing index data, performs a full walk
+	// and rewrites the index
+	Rescan bool
+}
+
+// DefaultScanIndexDir returns the default directory for scan index files
+// (e.g. ~/.cache/gz-git/index on Linux).
+func DefaultScanIndexDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine cache directory: %w", err)
+	}
+	return filepath.Join(cacheDir, "gz-git", "index"), nil
+}
+
+// scanIndexFile is the on-disk representation of a scan index.
+type scanIndexFile struct {
+	Version           int                       `json:"version"`
+	Root              string                    `json:"root"`
+	MaxDepth          int                       `json:"max_depth"`
+	IncludeSubmodules bool                      `json:"include_submodules"`
+	ScannedAt         time.Time                 `json:"scanned_at"`
+	Entries           map[string]scanIndexEntry `json:"entries"`
+}
+
+// scanIndexEntry caches what a walk learned about a single directory.
+type scanIndexEntry struct {
+	// ModTime is the directory's modification time in Unix nanoseconds
+	ModTime int64 `json:"mtime"`
+
+	// IsRepo indicates the directory is a Git repository
+	IsRepo bool `json:"repo,omitempty"`
+
+	// IsSubmodule indicates the repository is a submodule
+	IsSubmodule bool `json:"submodule,omitempty"`
+
+	// HasChildren indicates Children was read (directories at max depth are not read)
+	HasChildren bool `json:"has_children,omitempty"`
+
+	// Children are the names of subdirectories that are not ignored
+	Children []string `json:"children,omitempty"`
+}
+
+// scanIndex is an open index used during a single scan.
+// Entries visited by the scan are collected into a fresh map, so directories
+// that no longer exist are dropped when the index is saved.
+type scanIndex struct {
+	path      string
+	file      scanIndexFile
+	startedAt time.Time
+
+	mu      sync.Mutex
+	entries map[string]scanIndexEntry
+}
+
+// scanIndexPath returns the index file path for a scan configuration.
+func scanIndexPath(dir, root string, maxDepth int, includeSubmodules bool) string {
+	key := root + "\x00" + strconv.Itoa(maxDepth) + "\x00" + strconv.FormatBool(includeSubmodules)
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+// openScanIndex loads the index for a scan configuration.
+// The index is best-effort: a missing or unreadable index file yields an empty index,
+// and nil is returned only if no index location can be determined.
+func (c *client) openScanIndex(root string, maxDepth int, includeSubmodules bool, logger Logger) *scanIndex {
+	dir := c.scanIndex.Dir
+	if dir == "" {
+		defaultDir, err := DefaultScanIndexDir()
+		if err != nil {
+			logger.Debug("scan index disabled", "error", err)
+			return nil
+		}
+		dir = defaultDir
+	}
+
+	index := &scanIndex{
+		path: scanIndexPath(dir, root, maxDepth, includeSubmodules),
+		file: scanIndexFile{
+			Version:           scanIndexVersion,
+			Root:              root,
+			MaxDepth:          maxDepth,
+			IncludeSubmodules: includeSubmodules,
+		},
+		startedAt: time.Now(),
+		entries:   make(map[string]scanIndexEntry),
+	}
+
+	if c.scanIndex.Rescan {
+		logger.Debug("ignoring scan index (rescan requested)", "path", index.path)
+		return index
+	}
+
+	data, err := os.ReadFile(index.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Debug("cannot read scan index", "path", index.path, "error", err)
+		}
+		return index
+	}
+
+	var file scanIndexFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		logger.Debug("ignoring corrupt scan index", "path", index.path, "error", err)
+		return index
+	}
+
+	if file.Version != scanIndexVersion || file.Root != root ||
+		file.MaxDepth != maxDepth || file.IncludeSubmodules != includeSubmodules {
+		logger.Debug("ignoring stale scan index", "path", index.path)
+		return index
+	}
+
+	index.file = file
+	logger.Debug("loaded scan index", "path", index.path, "entries", len(file.Entries))
+	return index
+}
+
+// lookup returns the cached entry for dir if it is still valid for modTime.
+func (x *scanIndex) lookup(dir string, modTime time.Time, needChildren bool) (scanIndexEntry, bool) {
+	entry, ok := x.file.Entries[dir]
+	if !ok || entry.ModTime != modTime.UnixNano() {
+		return scanIndexEntry{}, false
+	}
+
+	// Distrust directories modified too close to the previous scan
+	if !modTime.Before(x.file.ScannedAt.Add(-scanIndexRacyWindow)) {
+		return scanIndexEntry{}, false
+	}
+
+	if needChildren && !entry.HasChildren {
+		return scanIndexEntry{}, false
+	}
+
+	return entry, true
+}
+
+// store records the entry for dir in the index being built.
+func (x *scanIndex) store(dir string, entry scanIndexEntry) {
+	x.mu.Lock()
+	x.entries[dir] = entry
+	x.mu.Unlock()
+}
+
+// save writes the entries visited by the scan, replacing the previous index.
+func (x *scanIndex) save(logger Logger) {
+	x.mu.Lock()
+	file := x.file
+	file.ScannedAt = x.startedAt
+	file.Entries = x.entries
+	x.mu.Unlock()
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		logger.Debug("cannot encode scan index", "error", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(x.path), 0o755); err != nil {
+		logger.Debug("cannot create scan index directory", "path", x.path, "error", err)
+		return
+	}
+
+	// Write atomically so concurrent scans never read a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(x.path), ".index-*.tmp")
+	if err != nil {
+		logger.Debug("cannot write scan index", "path", x.path, "error", err)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		logger.Debug("cannot write scan index", "path", x.path, "error", err)
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		logger.Debug("cannot write scan index", "path", x.path, "error", err)
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), x.path); err != nil {
+		logger.Debug("cannot write scan index", "path", x.path, "error", err)
+		return
+	}
+
+	logger.Debug("saved scan index", "path", x.path, "entries", len(file.Entries))
+}
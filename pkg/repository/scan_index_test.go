@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// makeFakeRepo creates a directory with an empty .git directory,
+// which is enough for repository discovery.
+func makeFakeRepo(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(path, ".git"), 0o755); err != nil {
+		t.Fatalf("Failed to create repository %s: %v", path, err)
+	}
+}
+
+// backdate sets the modification time of the given directories to a fixed time
+// in the past, so index entries for them are trusted on the next scan.
+func backdate(t *testing.T, paths ...string) {
+	t.Helper()
+	old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, path := range paths {
+		if err := os.Chtimes(path, old, old); err != nil {
+			t.Fatalf("Failed to backdate %s: %v", path, err)
+		}
+	}
+}
+
+func TestScanIndex(t *testing.T) {
+	root := t.TempDir()
+	indexDir := t.TempDir()
+	ctx := context.Background()
+
+	makeFakeRepo(t, filepath.Join(root, "alpha"))
+	makeFakeRepo(t, filepath.Join(root, "group", "beta"))
+	backdate(t, root, filepath.Join(root, "alpha"), filepath.Join(root, "group"), filepath.Join(root, "group", "beta"))
+
+	scan := func(rescan bool) []string {
+		t.Helper()
+		c := NewClient(WithScanIndex(ScanIndexOptions{Dir: indexDir, Rescan: rescan})).(*client)
+		repos, err := c.scanRepositoriesWithConfig(ctx, root, 2, &noopLogger{}, walkDirectoryConfig{})
+		if err != nil {
+			t.Fatalf("scan failed: %v", err)
+		}
+		for i := range repos {
+			repos[i], _ = filepath.Rel(root, repos[i])
+		}
+		return repos
+	}
+
+	// First scan builds the index
+	if got, want := scan(false), []string{"alpha", "group/beta"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("first scan = %v, want %v", got, want)
+	}
+	if _, err := os.Stat(scanIndexPath(indexDir, root, 2, false)); err != nil {
+		t.Fatalf("expected index file: %v", err)
+	}
+
+	// Add a repository but keep the parent's mtime: the cached entry is reused,
+	// which proves unchanged directories are not read again
+	makeFakeRepo(t, filepath.Join(root, "group", "gamma"))
+	backdate(t, filepath.Join(root, "group"))
+
+	if got, want := scan(false), []string{"alpha", "group/beta"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("cached scan = %v, want %v", got, want)
+	}
+
+	// Rescan ignores the index and finds the new repository
+	if got, want := scan(true), []string{"alpha", "group/beta", "group/gamma"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rescan = %v, want %v", got, want)
+	}
+
+	// A real change updates the directory mtime and is picked up incrementally
+	makeFakeRepo(t, filepath.Join(root, "delta"))
+	if got, want := scan(false), []string{"alpha", "delta", "group/beta", "group/gamma"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("incremental scan = %v, want %v", got, want)
+	}
+
+	// Removed repositories disappear
+	if err := os.RemoveAll(filepath.Join(root, "alpha")); err != nil {
+		t.Fatalf("Failed to remove repository: %v", err)
+	}
+	if got, want := scan(false), []string{"delta", "group/beta", "group/gamma"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("scan after removal = %v, want %v", got, want)
+	}
+}
+
+func TestScanIndexKeyedByConfiguration(t *testing.T) {
+	paths := map[string]bool{
+		scanIndexPath("/idx", "/work", 1, false):  true,
+		scanIndexPath("/idx", "/work", 2, false):  true,
+		scanIndexPath("/idx", "/work", 1, true):   true,
+		scanIndexPath("/idx", "/other", 1, false): true,
+	}
+
+	if len(paths) != 4 {
+		t.Errorf("expected distinct index files per configuration, got %v", paths)
+	}
+}
+
+func TestScanIndexCorruptFile(t *testing.T) {
+	root := t.TempDir()
+	indexDir := t.TempDir()
+	makeFakeRepo(t, filepath.Join(root, "alpha"))
+
+	path := scanIndexPath(indexDir, root, 1, false)
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatalf("Failed to write index: %v", err)
+	}
+
+	c := NewClient(WithScanIndex(ScanIndexOptions{Dir: indexDir})).(*client)
+	repos, err := c.scanRepositoriesWithConfig(context.Background(), root, 1, &noopLogger{}, walkDirectoryConfig{})
+	if err != nil {
+		t.Fatalf("scan failed: %v", err)
+	}
+	if len(repos) != 1 {
+		t.Errorf("expected 1 repository, got %v", repos)
+	}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gizzahub/gzh-cli-git/internal/gitcmd"
+)
+
+// normalizeSparsePaths validates cone-mode sparse checkout paths and returns them
+// cleaned (surrounding slashes removed), sorted and without duplicates.
+// Cone mode selects whole directories, so glob patterns and negations are rejected.
+func normalizeSparsePaths(paths []string) ([]string, error) {
+	var normalized []string
+	for _, p := range paths {
+		p = strings.Trim(strings.TrimSpace(p), "/")
+		if p == "" {
+			continue
+		}
+		if strings.ContainsAny(p, "*?[]!\\") || p == "." || p == ".." ||
+			strings.HasPrefix(p, "../") || strings.Contains(p, "/../") || strings.HasSuffix(p, "/..") {
+			return nil, &ValidationError{
+				Field:  "SparsePaths",
+				Value:  p,
+				Reason: "sparse checkout paths must be directories relative to the repository root, without patterns",
+			}
+		}
+		normalized = append(normalized, p)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// setSparseCheckout restricts the working tree of the repository at path to the
+// given directories (plus files in the repository root) using cone mode.
+func (c *client) setSparseCheckout(ctx context.Context, path string, paths []string) error {
+	args := append([]string{"sparse-checkout", "set", "--cone", "--"}, paths...)
+	result, err := c.executor.Run(ctx, path, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set sparse checkout: %w", err)
+	}
+	if result.ExitCode != 0 {
+		return &gitcmd.GitError{
+			Command:  "git " + strings.Join(args, " "),
+			ExitCode: result.ExitCode,
+			Stderr:   result.Stderr,
+		}
+	}
+	return nil
+}
+
+// sparseCheckoutPaths returns the cone-mode sparse checkout directories of the
+// repository at path, or nil if it is not a cone-mode sparse checkout.
+func (c *client) sparseCheckoutPaths(ctx context.Context, path string) []string {
+	if enabled, _ := c.executor.RunOutput(ctx, path, "config", "core.sparseCheckout"); enabled != "true" {
+		return nil
+	}
+	if cone, _ := c.executor.RunOutput(ctx, path, "config", "core.sparseCheckoutCone"); cone != "true" {
+		return nil
+	}
+
+	lines, err := c.executor.RunLines(ctx, path, "sparse-checkout", "list")
+	if err != nil {
+		return nil
+	}
+	paths, err := normalizeSparsePaths(lines)
+	if err != nil {
+		return nil
+	}
+	return paths
+}
+
+// syncSparseCheckout makes the sparse checkout of the repository at path match
+// the given directories. It reports whether the sparse set was changed.
+func (c *client) syncSparseCheckout(ctx context.Context, path string, paths []string, logger Logger) (bool, error) {
+	current := c.sparseCheckoutPaths(ctx, path)
+	if current != nil && slices.Equal(current, paths) {
+		logger.Debug("sparse checkout up to date", "path", path, "sparse", paths)
+		return false, nil
+	}
+
+	logger.Info("updating sparse checkout", "path", path, "from", current, "to", paths)
+	if err := c.setSparseCheckout(ctx, path, paths); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// initSparseRemote creates a bare repository with the directories docs, src/app and src/lib
+func initSparseRemote(t *testing.T, tmpDir string) string {
+	t.Helper()
+
+	seed := filepath.Join(tmpDir, "seed")
+	remote := filepath.Join(tmpDir, "remote.git")
+	initTagRepo(t, seed, remote)
+	for _, dir := range []string{"docs", "src/app", "src/lib"} {
+		if err := os.MkdirAll(filepath.Join(seed, dir), 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+		if err := os.WriteFile(filepath.Join(seed, dir, "file.txt"), []byte(dir+"\n"), 0o644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+	gitOutput(t, seed, "add", ".")
+	gitOutput(t, seed, "commit", "-q", "-m", "Add directories")
+	gitOutput(t, seed, "push", "-q", "origin", "HEAD")
+
+	return remote
+}
+
+// checkedOut reports which of the given directories exist in the working tree
+func checkedOut(path string, dirs ...string) []string {
+	var present []string
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(path, dir)); err == nil {
+			present = append(present, dir)
+		}
+	}
+	return present
+}
+
+func TestNormalizeSparsePaths(t *testing.T) {
+	got, err := normalizeSparsePaths([]string{" src/lib/ ", "docs", "", "/docs", "src/app"})
+	if err != nil {
+		t.Fatalf("normalizeSparsePaths() error = %v", err)
+	}
+	if want := []string{"docs", "src/app", "src/lib"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeSparsePaths() = %v, want %v", got, want)
+	}
+
+	for _, invalid := range []string{"src/*", "!docs", "../other", "src/../..", "."} {
+		if _, err := normalizeSparsePaths([]string{invalid}); err == nil {
+			t.Errorf("normalizeSparsePaths(%q) expected error", invalid)
+		}
+	}
+}
+
+func TestCloneSparse(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	remote := initSparseRemote(t, tmpDir)
+	clonePath := filepath.Join(tmpDir, "clone")
+
+	client := NewClient()
+	if _, err := client.Clone(ctx, CloneOptions{URL: remote, Destination: clonePath, SparsePaths: []string{"src/lib"}, Quiet: true}); err != nil {
+		t.Fatalf("Clone failed: %v", err)
+	}
+
+	if got := checkedOut(clonePath, "README.md", "docs", "src/app", "src/lib"); !reflect.DeepEqual(got, []string{"README.md", "src/lib"}) {
+		t.Errorf("checked out %v, want README.md and src/lib", got)
+	}
+	if got := gitOutput(t, clonePath, "sparse-checkout", "list"); got != "src/lib" {
+		t.Errorf("sparse-checkout list = %q, want src/lib", got)
+	}
+
+	// A sparse checkout needs a working tree
+	_, err := client.Clone(ctx, CloneOptions{URL: remote, Destination: filepath.Join(tmpDir, "bare"), SparsePaths: []string{"docs"}, Bare: true})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("Clone(bare, sparse) error = %v, want ValidationError", err)
+	}
+}
+
+func TestCloneOrUpdateSyncsSparsePaths(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	remote := initSparseRemote(t, tmpDir)
+	clonePath := filepath.Join(tmpDir, "clone")
+
+	client := NewClient()
+	opts := CloneOrUpdateOptions{URL: remote, Destination: clonePath, Strategy: StrategyReset, SparsePaths: []string{"docs"}}
+	result, err := client.CloneOrUpdate(ctx, opts)
+	if err != nil {
+		t.Fatalf("CloneOrUpdate (clone) failed: %v", err)
+	}
+	if result.Action != "cloned" {
+		t.Fatalf("Action = %s, want cloned", result.Action)
+	}
+	if got := checkedOut(clonePath, "docs", "src"); !reflect.DeepEqual(got, []string{"docs"}) {
+		t.Errorf("checked out %v after clone, want docs", got)
+	}
+
+	// The same sparse set leaves the checkout alone
+	result, err = client.CloneOrUpdate(ctx, opts)
+	if err != nil {
+		t.Fatalf("CloneOrUpdate (unchanged) failed: %v", err)
+	}
+	if strings.Contains(result.Message, "sparse checkout") {
+		t.Errorf("Message = %q, want no sparse checkout change", result.Message)
+	}
+
+	// A changed sparse set is applied to the existing repository
+	opts.SparsePaths = []string{"src/app", "src/lib"}
+	result, err = client.CloneOrUpdate(ctx, opts)
+	if err != nil {
+		t.Fatalf("CloneOrUpdate (changed) failed: %v", err)
+	}
+	if !strings.Contains(result.Message, "sparse checkout: src/app, src/lib") {
+		t.Errorf("Message = %q, want sparse checkout change", result.Message)
+	}
+	if got := checkedOut(clonePath, "docs", "src/app", "src/lib"); !reflect.DeepEqual(got, []string{"src/app", "src/lib"}) {
+		t.Errorf("checked out %v after update, want src/app and src/lib", got)
+	}
+
+	// The fetch strategy does not touch the working tree
+	opts.Strategy = StrategyFetch
+	opts.SparsePaths = []string{"docs"}
+	if _, err := client.CloneOrUpdate(ctx, opts); err != nil {
+		t.Fatalf("CloneOrUpdate (fetch) failed: %v", err)
+	}
+	if got := checkedOut(clonePath, "docs"); len(got) != 0 {
+		t.Errorf("fetch strategy changed the sparse checkout")
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StashLabelPrefix marks stash entries created by gz-git.
+// Labelled entries have messages of the form "gz-git:<operation>: <note>",
+// so they can be found again by the operation that created them.
+const StashLabelPrefix = "gz-git:"
+
+// Operations that create labelled stash entries.
+const (
+	// StashCreatorPull labels entries stashed by a bulk pull (BulkPullOptions.Stash)
+	StashCreatorPull = "pull"
+
+	// StashCreatorStash labels entries saved with BulkStash
+	StashCreatorStash = "stash"
+
+	// StashCreatorReset labels local changes saved before CloneOrUpdate resets with StrategyReset
+	StashCreatorReset = "reset"
+
+	// StashCreatorUndo labels local changes saved while Undo restores a repository
+	StashCreatorUndo = "undo"
+)
+
+// StashAction selects the BulkStash operation.
+type StashAction string
+
+const (
+	// StashSave stashes local changes
+	StashSave StashAction = "save"
+
+	// StashList lists stash entries
+	StashList StashAction = "list"
+
+	// StashPop applies and removes the newest matching stash entry
+	StashPop StashAction = "pop"
+
+	// StashDrop removes the newest matching stash entry without applying it
+	StashDrop StashAction = "drop"
+)
+
+// StashEntry describes a single stash entry.
+type StashEntry struct {
+	// Ref is the stash reference (e.g. "stash@{0}")
+	Ref string
+
+	// Index is the position in the stash list (0 is the newest)
+	Index int
+
+	// Branch is the branch the entry was created on
+	Branch string
+
+	// Message is the stash message
+	Message string
+
+	// CreatedBy is the gz-git operation that created the entry,
+	// or empty if the entry was not created by gz-git
+	CreatedBy string
+
+	// CreatedAt is when the entry was created
+	CreatedAt time.Time
+}
+
+// StashMessage returns a stash message labelled with the operation that creates it.
+func StashMessage(createdBy, note string) string {
+	label := StashLabelPrefix + createdBy
+	if note == "" {
+		return label
+	}
+	return label + ": " + note
+}
+
+// parseStashCreator returns the operation label of a stash message,
+// or empty if the message was not created by gz-git.
+func parseStashCreator(message string) string {
+	if !strings.HasPrefix(message, StashLabelPrefix) {
+		return ""
+	}
+	label := strings.TrimPrefix(message, StashLabelPrefix)
+	if idx := strings.Index(label, ":"); idx != -1 {
+		label = label[:idx]
+	}
+	return strings.TrimSpace(label)
+}
+
+// parseStashSubject splits a stash reflog subject into branch and message.
+// Subjects look like "On main: message" for named entries and
+// "WIP on main: abc1234 commit subject" for unnamed ones.
+func parseStashSubject(subject string) (branch, message string) {
+	rest := subject
+	switch {
+	case strings.HasPrefix(rest, "WIP on "):
+		rest = strings.TrimPrefix(rest, "WIP on ")
+	case strings.HasPrefix(rest, "On "):
+		rest = strings.TrimPrefix(rest, "On ")
+	default:
+		return "", subject
+	}
+
+	idx := strings.Index(rest, ": ")
+	if idx == -1 {
+		return "", subject
+	}
+	return rest[:idx], rest[idx+2:]
+}
+
+// listStashes returns the stash entries of a repository, newest first.
+func (c *client) listStashes(ctx context.Context, repoPath string) ([]StashEntry, error) {
+	result, err := c.executor.Run(ctx, repoPath, "stash", "list", "--format=%gd|%ct|%gs")
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("stash list failed: %s", strings.TrimSpace(result.Stderr))
+	}
+
+	var entries []StashEntry
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		parts := strings.SplitN(line, "|", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		entry := StashEntry{
+			Ref:   parts[0],
+			Index: len(entries),
+		}
+		if ts, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+			entry.CreatedAt = time.Unix(ts, 0)
+		}
+		entry.Branch, entry.Message = parseStashSubject(parts[2])
+		entry.CreatedBy = parseStashCreator(entry.Message)
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// filterStashes returns the entries created by the given operation,
+// or all entries if createdBy is empty.
+func filterStashes(entries []StashEntry, createdBy string) []StashEntry {
+	if createdBy == "" {
+		return entries
+	}
+
+	var filtered []StashEntry
+	for _, entry := range entries {
+		if entry.CreatedBy == createdBy {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
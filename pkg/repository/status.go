@@ -62,6 +62,93 @@ const (
 	// StatusWouldPush indicates the operation would push (dry-run mode).
 	StatusWouldPush = "would-push"
 
+	// StatusWouldClone indicates the operation would clone (dry-run mode).
+	StatusWouldClone = "would-clone"
+
+	// StatusWouldRun indicates the command would be run (dry-run mode).
+	StatusWouldRun = "would-run"
+
+	// StatusStashed indicates local changes were stashed.
+	StatusStashed = "stashed"
+
+	// StatusPopped indicates a stash entry was applied and removed.
+	StatusPopped = "popped"
+
+	// StatusDropped indicates a stash entry was removed.
+	StatusDropped = "dropped"
+
+	// StatusHasStash indicates the repository has matching stash entries.
+	StatusHasStash = "has-stash"
+
+	// StatusNoStash indicates the repository has no matching stash entries.
+	StatusNoStash = "no-stash"
+
+	// StatusWouldStash indicates local changes would be stashed (dry-run mode).
+	StatusWouldStash = "would-stash"
+
+	// StatusWouldPop indicates a stash entry would be popped (dry-run mode).
+	StatusWouldPop = "would-pop"
+
+	// StatusWouldDrop indicates a stash entry would be dropped (dry-run mode).
+	StatusWouldDrop = "would-drop"
+
+	// StatusTagged indicates a tag was created.
+	StatusTagged = "tagged"
+
+	// StatusTagExists indicates the tag already exists.
+	StatusTagExists = "tag-exists"
+
+	// StatusWrongBranch indicates the repository is not on the expected branch.
+	StatusWrongBranch = "wrong-branch"
+
+	// StatusRolledBack indicates a completed change was undone because another repository failed.
+	StatusRolledBack = "rolled-back"
+
+	// StatusWouldTag indicates a tag would be created (dry-run mode).
+	StatusWouldTag = "would-tag"
+
+	// StatusCommitted indicates a commit was created.
+	StatusCommitted = "committed"
+
+	// StatusWouldCommit indicates a commit would be created (dry-run mode).
+	StatusWouldCommit = "would-commit"
+
+	// StatusDeleted indicates branches were deleted.
+	StatusDeleted = "deleted"
+
+	// StatusWouldDelete indicates branches would be deleted (dry-run mode).
+	StatusWouldDelete = "would-delete"
+
+	// StatusRestored indicates the repository was restored to its state before a run.
+	StatusRestored = "restored"
+
+	// StatusWouldRestore indicates the repository would be restored (dry-run mode).
+	StatusWouldRestore = "would-restore"
+
+	// StatusNoMatch indicates the repository has nothing matching the requested pattern.
+	StatusNoMatch = "no-match"
+
+	// StatusUnreachable indicates a remote could not be reached.
+	StatusUnreachable = "unreachable"
+
+	// StatusMaintained indicates maintenance tasks were run.
+	StatusMaintained = "maintained"
+
+	// StatusWouldMaintain indicates maintenance tasks would be run (dry-run mode).
+	StatusWouldMaintain = "would-maintain"
+
+	// StatusHealthy indicates no problems were found in the repository.
+	StatusHealthy = "healthy"
+
+	// StatusUnhealthy indicates the repository has problems that were not fixed.
+	StatusUnhealthy = "unhealthy"
+
+	// StatusFixed indicates the problems found in the repository were fixed.
+	StatusFixed = "fixed"
+
+	// StatusIncoming indicates the upstream has commits not yet in the local branch.
+	StatusIncoming = "incoming"
+
 	// StatusNothingToPush is deprecated. Use StatusUpToDate instead.
 	// Kept for backward compatibility.
 	StatusNothingToPush = "nothing-to-push"
@@ -103,7 +190,9 @@ func IsSuccessStatus(status string) bool {
 	case StatusUpdated, StatusSuccess, StatusUpToDate,
 		StatusFetched, StatusPulled, StatusPushed,
 		StatusCloned, StatusRebased, StatusReset,
-		StatusSwitched, StatusAlreadyOnBranch, StatusBranchCreated:
+		StatusSwitched, StatusAlreadyOnBranch, StatusBranchCreated,
+		StatusStashed, StatusPopped, StatusDropped, StatusTagged, StatusCommitted, StatusDeleted,
+		StatusRestored, StatusMaintained, StatusHealthy, StatusFixed:
 		return true
 	default:
 		return false
@@ -113,7 +202,9 @@ func IsSuccessStatus(status string) bool {
 // IsDryRunStatus returns true if the status indicates a dry-run simulation.
 func IsDryRunStatus(status string) bool {
 	switch status {
-	case StatusWouldUpdate, StatusWouldFetch, StatusWouldPull, StatusWouldPush, StatusWouldSwitch:
+	case StatusWouldUpdate, StatusWouldFetch, StatusWouldPull, StatusWouldPush, StatusWouldSwitch,
+		StatusWouldClone, StatusWouldRun, StatusWouldStash, StatusWouldPop, StatusWouldDrop,
+		StatusWouldTag, StatusWouldCommit, StatusWouldDelete, StatusWouldRestore, StatusWouldMaintain:
 		return true
 	default:
 		return false
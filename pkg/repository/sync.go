@@ -0,0 +1,515 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultWorkspaceManifest is the default file name of a workspace manifest.
+const DefaultWorkspaceManifest = ".gz-git.yaml"
+
+// WorkspaceManifest declares the repositories that make up a workspace.
+//
+// Example manifest:
+//
+//	strategy: rebase
+//	parallel: 8
+//	repositories:
+//	  - url: https://github.com/user/api.git
+//	    path: services/api
+//	    branch: develop
+//	  - url: git@github.com:user/web.git
+//	    strategy: pull
+//	    groups: [frontend]
+//	groups:
+//	  backend: [services/*]
+//	  deprecated: [legacy-api]
+type WorkspaceManifest struct {
+	// Root is the base directory for relative repository paths.
+	// Defaults to the directory containing the manifest file.
+	Root string `yaml:"root,omitempty"`
+
+	// Strategy is the default update strategy for entries without one.
+	// Defaults to StrategyRebase.
+	Strategy UpdateStrategy `yaml:"strategy,omitempty"`
+
+	// Parallel is the default number of concurrent workers.
+	Parallel int `yaml:"parallel,omitempty"`
+
+	// Repositories lists the repositories of the workspace.
+	Repositories []ManifestRepository `yaml:"repositories"`
+
+	// Groups maps group names to path patterns relative to Root.
+	// Patterns use filepath.Match syntax, so repositories that are not
+	// listed in Repositories can be grouped as well.
+	Groups map[string][]string `yaml:"groups,omitempty"`
+}
+
+// ManifestRepository describes a single repository entry in a workspace manifest.
+type ManifestRepository struct {
+	// URL is the repository URL to clone (required).
+	URL string `yaml:"url"`
+
+	// Path is the target path, relative to the manifest root.
+	// Defaults to the repository name extracted from URL.
+	Path string `yaml:"path,omitempty"`
+
+	// Branch is the branch to check out (default: remote default branch).
+	Branch string `yaml:"branch,omitempty"`
+
+	// Strategy overrides the manifest-wide update strategy for this entry.
+	Strategy UpdateStrategy `yaml:"strategy,omitempty"`
+
+	// Depth limits the clone depth (0 means full clone).
+	Depth int `yaml:"depth,omitempty"`
+
+	// Groups lists the groups (tags) this repository belongs to.
+	Groups []string `yaml:"groups,omitempty"`
+}
+
+// LoadWorkspaceManifest reads and validates a workspace manifest from a YAML file.
+// Relative paths in the manifest are resolved against the manifest's directory.
+func LoadWorkspaceManifest(path string) (*WorkspaceManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest path: %w", err)
+	}
+
+	return ParseWorkspaceManifest(data, filepath.Dir(absPath))
+}
+
+// ParseWorkspaceManifest parses and validates a workspace manifest.
+// baseDir is used as the root when the manifest does not declare one,
+// and to resolve a relative root. A leading ~ in the root is expanded
+// to the user's home directory.
+func ParseWorkspaceManifest(data []byte, baseDir string) (*WorkspaceManifest, error) {
+	var manifest WorkspaceManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	root, err := expandHome(manifest.Root)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Root = root
+
+	switch {
+	case manifest.Root == "":
+		manifest.Root = baseDir
+	case !filepath.IsAbs(manifest.Root):
+		manifest.Root = filepath.Join(baseDir, manifest.Root)
+	}
+
+	if manifest.Strategy == "" {
+		manifest.Strategy = StrategyRebase
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+// expandHome replaces a leading ~ or ~/ in path with the user's home directory
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to expand %s: %w", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+// Validate checks the manifest for missing URLs, invalid strategies and duplicate paths.
+func (m *WorkspaceManifest) Validate() error {
+	if m.Strategy != "" && !isValidUpdateStrategy(m.Strategy) {
+		return &ValidationError{
+			Field:  "strategy",
+			Value:  string(m.Strategy),
+			Reason: fmt.Sprintf("invalid strategy, must be one of: %s", getValidStrategies()),
+		}
+	}
+
+	if err := m.validateGroups(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]int, len(m.Repositories))
+	for i, entry := range m.Repositories {
+		if entry.URL == "" {
+			return &ValidationError{
+				Field:  fmt.Sprintf("repositories[%d].url", i),
+				Value:  entry.URL,
+				Reason: "URL is required",
+			}
+		}
+
+		if entry.Strategy != "" && !isValidUpdateStrategy(entry.Strategy) {
+			return &ValidationError{
+				Field:  fmt.Sprintf("repositories[%d].strategy", i),
+				Value:  string(entry.Strategy),
+				Reason: fmt.Sprintf("invalid strategy, must be one of: %s", getValidStrategies()),
+			}
+		}
+
+		target, err := m.TargetPath(entry)
+		if err != nil {
+			return &ValidationError{
+				Field:  fmt.Sprintf("repositories[%d].path", i),
+				Value:  entry.Path,
+				Reason: err.Error(),
+			}
+		}
+
+		if prev, ok := seen[target]; ok {
+			return &ValidationError{
+				Field:  fmt.Sprintf("repositories[%d].path", i),
+				Value:  target,
+				Reason: fmt.Sprintf("duplicates path of repositories[%d]", prev),
+			}
+		}
+		seen[target] = i
+	}
+
+	return nil
+}
+
+// TargetPath returns the absolute destination of a manifest entry.
+func (m *WorkspaceManifest) TargetPath(entry ManifestRepository) (string, error) {
+	path := entry.Path
+	if path == "" {
+		name, err := ExtractRepoNameFromURL(entry.URL)
+		if err != nil {
+			return "", err
+		}
+		path = name
+	}
+
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path), nil
+	}
+
+	return filepath.Join(m.Root, path), nil
+}
+
+// StrategyFor returns the effective update strategy of a manifest entry.
+func (m *WorkspaceManifest) StrategyFor(entry ManifestRepository) UpdateStrategy {
+	if entry.Strategy != "" {
+		return entry.Strategy
+	}
+	if m.Strategy != "" {
+		return m.Strategy
+	}
+	return StrategyRebase
+}
+
+// SyncOptions configures a workspace sync operation.
+type SyncOptions struct {
+	// Manifest is the workspace manifest to apply (required)
+	Manifest *WorkspaceManifest
+
+	// Parallel is the number of concurrent workers
+	// (default: manifest value, then DefaultBulkParallel)
+	Parallel int
+
+	// HostLimits caps concurrent operations per remote host on top of Parallel
+	// (e.g. {"git.internal": 3}); hosts without a limit are only bound by Parallel
+	HostLimits HostLimits
+
+	// DryRun reports what would be done without cloning or updating
+	DryRun bool
+
+	// Force allows replacing non-repository directories at target paths
+	Force bool
+
+	// Groups syncs only entries in at least one of these manifest groups
+	Groups []string
+
+	// ExcludeGroups skips entries in any of these manifest groups
+	ExcludeGroups []string
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositorySyncResult)
+}
+
+// SyncResult contains the results of a workspace sync operation
+type SyncResult struct {
+	// Root is the manifest root directory
+	Root string
+
+	// TotalProcessed is the number of manifest entries processed
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositorySyncResult
+
+	// Duration is the total operation time
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+}
+
+// RepositorySyncResult represents the result for a single manifest entry
+type RepositorySyncResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to the manifest root
+	RelativePath string
+
+	// URL is the repository URL from the manifest
+	URL string
+
+	// Branch is the requested branch (empty for remote default)
+	Branch string
+
+	// Strategy is the update strategy applied to an existing repository
+	Strategy UpdateStrategy
+
+	// Action is the action reported by CloneOrUpdate (cloned, rebased, skipped, ...)
+	Action string
+
+	// Status is the operation status (cloned, pulled, rebased, error, etc.)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Undo is the state before a reset, for undoing it (nil for other strategies)
+	Undo *UndoState
+}
+
+// Sync clones missing repositories and updates existing ones as declared by a workspace manifest.
+// Entries are processed in parallel using CloneOrUpdate with each entry's effective strategy.
+func (c *client) Sync(ctx context.Context, opts SyncOptions) (*SyncResult, error) {
+	startTime := time.Now()
+
+	if opts.Manifest == nil {
+		return nil, &ValidationError{
+			Field:  "Manifest",
+			Value:  "",
+			Reason: "manifest is required",
+		}
+	}
+
+	if err := opts.Manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.Parallel <= 0 {
+		opts.Parallel = opts.Manifest.Parallel
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = DefaultBulkParallel
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = &noopLogger{}
+	}
+
+	// Select entries by group
+	entries := opts.Manifest.Repositories
+	if len(opts.Groups) > 0 || len(opts.ExcludeGroups) > 0 {
+		groups, err := newGroupFilter(opts.Groups, opts.ExcludeGroups, opts.Manifest, opts.Manifest.Root)
+		if err != nil {
+			return nil, err
+		}
+
+		var selected []ManifestRepository
+		for _, entry := range entries {
+			target, _ := opts.Manifest.TargetPath(entry) // validated above
+			if groups.match(target) {
+				selected = append(selected, entry)
+			}
+		}
+		entries = selected
+	}
+
+	if len(entries) == 0 {
+		return &SyncResult{
+			Root:           opts.Manifest.Root,
+			TotalProcessed: 0,
+			Repositories:   []RepositorySyncResult{},
+			Duration:       time.Since(startTime),
+			Summary:        map[string]int{},
+		}, nil
+	}
+
+	results, err := c.processSyncRepositories(ctx, entries, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process repositories: %w", err)
+	}
+
+	return &SyncResult{
+		Root:           opts.Manifest.Root,
+		TotalProcessed: len(results),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        calculateSyncSummary(results),
+	}, nil
+}
+
+// processSyncRepositories processes manifest entries in parallel
+func (c *client) processSyncRepositories(ctx context.Context, entries []ManifestRepository, opts SyncOptions) ([]RepositorySyncResult, error) {
+	results := make([]RepositorySyncResult, len(entries))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(entries))
+	hosts := newHostLimiter(opts.HostLimits)
+	remoteURLs := make([]string, len(entries))
+	for i, entry := range entries {
+		remoteURLs[i] = entry.URL
+	}
+
+	// Process entries; each waits for a slot on its remote host first
+	hosts.run(ctx, len(entries), opts.Parallel, remoteURLs, func(ctx context.Context, i int) {
+		entry := entries[i]
+		target, _ := opts.Manifest.TargetPath(entry) // validated in Sync
+
+		// Call progress callback
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(i+1, len(entries), target)
+		}
+		events.Started(i+1, target)
+		result := c.processSyncRepository(ctx, entry, target, opts)
+
+		mu.Lock()
+		results[i] = result
+		if opts.ResultCallback != nil {
+			opts.ResultCallback(result)
+		}
+		events.Finished(i+1, target, result.Status, result.Duration)
+		mu.Unlock()
+	})
+
+	return results, nil
+}
+
+// processSyncRepository clones or updates a single manifest entry
+func (c *client) processSyncRepository(ctx context.Context, entry ManifestRepository, target string, opts SyncOptions) RepositorySyncResult {
+	startTime := time.Now()
+
+	result := RepositorySyncResult{
+		Path:         target,
+		RelativePath: RelativePath(opts.Manifest.Root, target),
+		URL:          entry.URL,
+		Branch:       entry.Branch,
+		Strategy:     opts.Manifest.StrategyFor(entry),
+	}
+
+	// Dry run - only report what CloneOrUpdate would do
+	if opts.DryRun {
+		exists, isGitRepo, err := checkTargetDirectory(target)
+		switch {
+		case err != nil:
+			result.Status = StatusError
+			result.Message = "Failed to check target directory"
+			result.Error = err
+		case !exists:
+			result.Status = StatusWouldClone
+			result.Message = fmt.Sprintf("Would clone %s", entry.URL)
+		case !isGitRepo && !opts.Force && result.Strategy != StrategyClone:
+			result.Status = StatusError
+			result.Message = "Target exists but is not a git repository"
+			result.Error = fmt.Errorf("target directory '%s' exists but is not a git repository", target)
+		default:
+			result.Status = StatusWouldUpdate
+			result.Message = fmt.Sprintf("Would update using %s strategy", result.Strategy)
+		}
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	updateResult, err := c.CloneOrUpdate(ctx, CloneOrUpdateOptions{
+		URL:         entry.URL,
+		Destination: target,
+		Strategy:    result.Strategy,
+		Branch:      entry.Branch,
+		Depth:       entry.Depth,
+		Force:       opts.Force,
+		Logger:      opts.Logger,
+	})
+	if err != nil {
+		result.Status = StatusError
+		result.Message = "Clone or update failed"
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		opts.Logger.Error("sync failed", "path", result.RelativePath, "error", err)
+		return result
+	}
+
+	result.Action = updateResult.Action
+	result.Status = syncStatusForAction(updateResult.Action)
+	result.Message = updateResult.Message
+	result.Undo = updateResult.Undo
+	result.Duration = time.Since(startTime)
+
+	opts.Logger.Info("repository synced", "path", result.RelativePath, "action", result.Action)
+
+	return result
+}
+
+// syncStatusForAction maps a CloneOrUpdateResult action to a bulk status constant
+func syncStatusForAction(action string) string {
+	switch action {
+	case "cloned":
+		return StatusCloned
+	case "skipped":
+		return StatusSkipped
+	case "fetched":
+		return StatusFetched
+	case "pulled":
+		return StatusPulled
+	case "reset":
+		return StatusReset
+	case "rebased":
+		return StatusRebased
+	default:
+		return StatusUpdated
+	}
+}
+
+// calculateSyncSummary creates a summary of sync results by status
+func calculateSyncSummary(results []RepositorySyncResult) map[string]int {
+	summary := make(map[string]int)
+
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return summary
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseWorkspaceManifest(t *testing.T) {
+	data := []byte(`
+strategy: pull
+parallel: 3
+repositories:
+  - url: https://github.com/user/api.git
+    path: services/api
+    branch: develop
+  - url: git@github.com:user/web.git
+    strategy: skip
+`)
+
+	manifest, err := ParseWorkspaceManifest(data, "/work")
+	if err != nil {
+		t.Fatalf("ParseWorkspaceManifest failed: %v", err)
+	}
+
+	if manifest.Root != "/work" {
+		t.Errorf("Root = %q, want /work", manifest.Root)
+	}
+	if manifest.Parallel != 3 {
+		t.Errorf("Parallel = %d, want 3", manifest.Parallel)
+	}
+	if len(manifest.Repositories) != 2 {
+		t.Fatalf("expected 2 repositories, got %d", len(manifest.Repositories))
+	}
+
+	tests := []struct {
+		entry        ManifestRepository
+		wantPath     string
+		wantStrategy UpdateStrategy
+	}{
+		{manifest.Repositories[0], "/work/services/api", StrategyPull},
+		{manifest.Repositories[1], "/work/web", StrategySkip},
+	}
+
+	for _, tt := range tests {
+		path, err := manifest.TargetPath(tt.entry)
+		if err != nil {
+			t.Errorf("TargetPath(%s) failed: %v", tt.entry.URL, err)
+		}
+		if path != tt.wantPath {
+			t.Errorf("TargetPath(%s) = %q, want %q", tt.entry.URL, path, tt.wantPath)
+		}
+		if got := manifest.StrategyFor(tt.entry); got != tt.wantStrategy {
+			t.Errorf("StrategyFor(%s) = %q, want %q", tt.entry.URL, got, tt.wantStrategy)
+		}
+	}
+}
+
+func TestParseWorkspaceManifestDefaults(t *testing.T) {
+	manifest, err := ParseWorkspaceManifest([]byte("root: sub\nrepositories: []\n"), "/work")
+	if err != nil {
+		t.Fatalf("ParseWorkspaceManifest failed: %v", err)
+	}
+
+	if manifest.Root != "/work/sub" {
+		t.Errorf("Root = %q, want /work/sub", manifest.Root)
+	}
+	if manifest.Strategy != StrategyRebase {
+		t.Errorf("Strategy = %q, want %q", manifest.Strategy, StrategyRebase)
+	}
+}
+
+func TestParseWorkspaceManifestHomeRoot(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	tests := []struct {
+		root string
+		want string
+	}{
+		{"~", home},
+		{"~/workspace", filepath.Join(home, "workspace")},
+		{"~user/workspace", "/work/~user/workspace"},
+	}
+
+	for _, tt := range tests {
+		manifest, err := ParseWorkspaceManifest([]byte("root: \""+tt.root+"\"\nrepositories: []\n"), "/work")
+		if err != nil {
+			t.Fatalf("ParseWorkspaceManifest(root %s) failed: %v", tt.root, err)
+		}
+		if manifest.Root != tt.want {
+			t.Errorf("root %s: Root = %q, want %q", tt.root, manifest.Root, tt.want)
+		}
+	}
+}
+
+func TestParseWorkspaceManifestValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing url", "repositories:\n  - path: foo\n"},
+		{"invalid default strategy", "strategy: merge\nrepositories: []\n"},
+		{"invalid entry strategy", "repositories:\n  - url: https://h/a.git\n    strategy: bogus\n"},
+		{"duplicate path", "repositories:\n  - url: https://h/a.git\n  - url: https://other/a.git\n"},
+		{"invalid yaml", "repositories: [\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseWorkspaceManifest([]byte(tt.data), "/work"); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestSyncRequiresManifest(t *testing.T) {
+	client := NewClient()
+
+	if _, err := client.Sync(context.Background(), SyncOptions{}); err == nil {
+		t.Error("expected error for missing manifest")
+	}
+}
+
+func TestSync(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Create a source repository to clone from
+	sourcePath := filepath.Join(tmpDir, "source")
+	if err := os.MkdirAll(sourcePath, 0o755); err != nil {
+		t.Fatalf("Failed to create source: %v", err)
+	}
+	if err := initGitRepoWithCommit(sourcePath); err != nil {
+		t.Skipf("Skipping test: git not available: %v", err)
+	}
+
+	workspace := filepath.Join(tmpDir, "workspace")
+	manifest := &WorkspaceManifest{
+		Root:     workspace,
+		Strategy: StrategyFetch,
+		Repositories: []ManifestRepository{
+			{URL: sourcePath, Path: "one"},
+			{URL: sourcePath, Path: "two", Strategy: StrategySkip},
+		},
+	}
+
+	ctx := context.Background()
+	client := NewClient()
+
+	// Dry run reports clones without touching the filesystem
+	result, err := client.Sync(ctx, SyncOptions{Manifest: manifest, DryRun: true})
+	if err != nil {
+		t.Fatalf("Sync dry-run failed: %v", err)
+	}
+	if result.Summary[StatusWouldClone] != 2 {
+		t.Errorf("expected 2 would-clone results, got %v", result.Summary)
+	}
+	if _, err := os.Stat(workspace); !os.IsNotExist(err) {
+		t.Error("dry-run should not create the workspace")
+	}
+
+	// First sync clones everything
+	result, err = client.Sync(ctx, SyncOptions{Manifest: manifest, Parallel: 2})
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if result.Summary[StatusCloned] != 2 {
+		t.Fatalf("expected 2 cloned results, got %v (%+v)", result.Summary, result.Repositories)
+	}
+	for _, repo := range result.Repositories {
+		if !client.IsRepository(ctx, repo.Path) {
+			t.Errorf("expected repository at %s", repo.Path)
+		}
+	}
+
+	// Second sync applies each entry's strategy
+	result, err = client.Sync(ctx, SyncOptions{Manifest: manifest})
+	if err != nil {
+		t.Fatalf("second Sync failed: %v", err)
+	}
+	if result.Repositories[0].Status != StatusFetched {
+		t.Errorf("expected first entry to be fetched, got %s: %v", result.Repositories[0].Status, result.Repositories[0].Error)
+	}
+	if result.Repositories[1].Status != StatusSkipped {
+		t.Errorf("expected second entry to be skipped, got %s", result.Repositories[1].Status)
+	}
+}
+
+func TestSyncStatusForAction(t *testing.T) {
+	tests := map[string]string{
+		"cloned":  StatusCloned,
+		"skipped": StatusSkipped,
+		"fetched": StatusFetched,
+		"pulled":  StatusPulled,
+		"reset":   StatusReset,
+		"rebased": StatusRebased,
+		"other":   StatusUpdated,
+	}
+
+	for action, want := range tests {
+		if got := syncStatusForAction(action); got != want {
+			t.Errorf("syncStatusForAction(%q) = %q, want %q", action, got, want)
+		}
+	}
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// UndoState is the state of a repository before a bulk operation changed it,
+// recorded so that Undo can restore it. It is set by BulkPull, BulkSwitch and
+// CloneOrUpdate with StrategyReset, and persisted in the run journal.
+type UndoState struct {
+	// Branch is the branch checked out before the operation (empty if HEAD was detached)
+	Branch string `json:"branch"`
+
+	// Head is the HEAD commit before the operation
+	Head string `json:"head"`
+
+	// Stash is the stash commit the operation saved local changes in, if any
+	Stash string `json:"stash,omitempty"`
+
+	// AfterBranch is the branch checked out after the operation
+	AfterBranch string `json:"after_branch"`
+
+	// AfterHead is the HEAD commit after the operation; Undo skips
+	// repositories that moved on since, unless forced
+	AfterHead string `json:"after_head"`
+}
+
+// changed reports whether the operation changed the repository, i.e. whether
+// there is anything to undo. A nil state has nothing to undo.
+func (s *UndoState) changed() bool {
+	if s == nil {
+		return false
+	}
+	return s.Branch != s.AfterBranch || s.Head != s.AfterHead || s.Stash != ""
+}
+
+// UndoOptions configures an undo of a recorded run.
+type UndoOptions struct {
+	// Run is the recorded run to undo (required)
+	Run *Run
+
+	// Parallel is the number of concurrent workers (default: 5)
+	Parallel int
+
+	// DryRun reports what would be restored without changing anything
+	DryRun bool
+
+	// Force restores repositories that changed since the run.
+	// Commits made since the run are no longer on the restored branch.
+	Force bool
+
+	// Logger for operation feedback
+	Logger Logger
+
+	// ProgressCallback is called for each processed repository
+	ProgressCallback func(current, total int, repo string)
+
+	// ProgressEventCallback is called when a repository starts and finishes processing.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ProgressEventCallback func(event ProgressEvent)
+
+	// ResultCallback is called with each repository result as soon as it completes.
+	// Calls are serialized, so the callback does not need to be safe for concurrent use.
+	ResultCallback func(result RepositoryUndoResult)
+}
+
+// UndoResult contains the results of an undo
+type UndoResult struct {
+	// Run is the run that was undone
+	Run *Run
+
+	// TotalProcessed is the number of repositories with a recorded undo state
+	TotalProcessed int
+
+	// Repositories contains individual repository results
+	Repositories []RepositoryUndoResult
+
+	// Duration is the total time taken
+	Duration time.Duration
+
+	// Summary contains status counts
+	Summary map[string]int
+}
+
+// RepositoryUndoResult represents the result for a single repository undo
+type RepositoryUndoResult struct {
+	// Path is the repository path
+	Path string
+
+	// RelativePath is the path relative to the run directory
+	RelativePath string
+
+	// Status is the operation status (restored, skipped, error, etc.)
+	Status string
+
+	// Message is a human-readable status message
+	Message string
+
+	// Error if the operation failed
+	Error error
+
+	// Duration is how long this repository took to process
+	Duration time.Duration
+
+	// Branch is the restored branch (empty if HEAD was detached)
+	Branch string
+
+	// Head is the restored HEAD commit
+	Head string
+}
+
+// Undo restores every repository of a recorded run to its state before the run:
+// the previous branch and HEAD commit, and the local changes saved in a stash.
+// Local changes made since the run are kept.
+func (c *client) Undo(ctx context.Context, opts UndoOptions) (*UndoResult, error) {
+	startTime := time.Now()
+
+	if opts.Run == nil {
+		return nil, fmt.Errorf("run is required")
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = DefaultBulkParallel
+	}
+	if opts.Logger == nil {
+		opts.Logger = &noopLogger{}
+	}
+
+	repos := make([]RunRepository, 0, len(opts.Run.Repositories))
+	for _, repo := range opts.Run.Repositories {
+		if repo.Undo != nil {
+			repos = append(repos, repo)
+		}
+	}
+
+	results := make([]RepositoryUndoResult, len(repos))
+	var mu sync.Mutex
+	events := NewProgressEvents(opts.ProgressEventCallback, len(repos))
+
+	// Create error group with concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Parallel)
+
+	for i, repo := range repos {
+		i, repo := i, repo // capture loop variables
+
+		g.Go(func() error {
+			// Call progress callback
+			if opts.ProgressCallback != nil {
+				opts.ProgressCallback(i+1, len(repos), repo.Path)
+			}
+			events.Started(i+1, repo.Path)
+
+			result := c.undoRepository(gctx, repo, opts)
+
+			mu.Lock()
+			results[i] = result
+			if opts.ResultCallback != nil {
+				opts.ResultCallback(result)
+			}
+			events.Finished(i+1, repo.Path, result.Status, result.Duration)
+			mu.Unlock()
+
+			return nil // Don't fail entire operation on single repo error
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	summary := make(map[string]int)
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return &UndoResult{
+		Run:            opts.Run,
+		TotalProcessed: len(repos),
+		Repositories:   results,
+		Duration:       time.Since(startTime),
+		Summary:        summary,
+	}, nil
+}
+
+// undoRepository restores a single repository to its recorded state
+func (c *client) undoRepository(ctx context.Context, repo RunRepository, opts UndoOptions) RepositoryUndoResult {
+	startTime := time.Now()
+	logger := opts.Logger
+	undo := repo.Undo
+
+	result := RepositoryUndoResult{
+		Path:         repo.Path,
+		RelativePath: repo.RelativePath,
+		Branch:       undo.Branch,
+		Head:         undo.Head,
+	}
+	finish := func(status, message string, err error) RepositoryUndoResult {
+		result.Status = status
+		result.Message = message
+		result.Error = err
+		result.Duration = time.Since(startTime)
+		return result
+	}
+
+	if !c.IsRepository(ctx, repo.Path) {
+		return finish(StatusError, "Repository no longer exists", fmt.Errorf("not a git repository: %s", repo.Path))
+	}
+
+	branch, head, err := c.headState(ctx, repo.Path)
+	if err != nil {
+		return finish(StatusError, "Failed to read HEAD", err)
+	}
+
+	// Local changes saved by the operation are restored only if still stashed
+	stashRef, err := c.findStash(ctx, repo.Path, undo.Stash)
+	if err != nil {
+		return finish(StatusError, "Failed to list stash entries", err)
+	}
+
+	if branch == undo.Branch && head == undo.Head && stashRef == "" {
+		return finish(StatusSkipped, "Already at the state before the run", nil)
+	}
+
+	if !opts.Force && (branch != undo.AfterBranch || head != undo.AfterHead) {
+		return finish(StatusSkipped, fmt.Sprintf("Changed since the run (now %s) - use --force to restore anyway", describeHead(branch, head)), nil)
+	}
+
+	// Restoring is not possible in the middle of a merge or rebase
+	repoState, err := c.checkRepositoryState(ctx, repo.Path)
+	if err != nil {
+		return finish(StatusError, "Failed to check repository state", err)
+	}
+	switch {
+	case repoState.HasConflicts:
+		return finish(StatusConflict, fmt.Sprintf("Repository has conflicts in %d file(s) - skipping", len(repoState.ConflictedFiles)), nil)
+	case repoState.RebaseInProgress:
+		return finish(StatusRebaseInProgress, "Repository has rebase in progress - skipping", nil)
+	case repoState.MergeInProgress:
+		return finish(StatusMergeInProgress, "Repository has merge in progress - skipping", nil)
+	}
+
+	target := describeHead(undo.Branch, undo.Head)
+	if opts.DryRun {
+		message := fmt.Sprintf("Would restore %s", target)
+		if stashRef != "" {
+			message += " and re-apply stashed changes"
+		}
+		return finish(StatusWouldRestore, message, nil)
+	}
+
+	// Keep local changes made since the run
+	changes, err := c.executor.RunOutput(ctx, repo.Path, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return finish(StatusError, "Failed to get repository status", err)
+	}
+	stashedLocal := false
+	if changes != "" {
+		if _, err := c.executor.RunOutput(ctx, repo.Path, "stash", "push", "-m", StashMessage(StashCreatorUndo, "local changes before undo")); err != nil {
+			return finish(StatusError, "Failed to stash local changes", err)
+		}
+		stashedLocal = true
+	}
+
+	if err := c.restoreHead(ctx, repo.Path, branch, undo); err != nil {
+		if stashedLocal {
+			_, _ = c.executor.Run(ctx, repo.Path, "stash", "pop") // Best effort, the entry is labelled otherwise
+		}
+		return finish(StatusError, fmt.Sprintf("Failed to restore %s", target), err)
+	}
+
+	message := fmt.Sprintf("Restored %s", target)
+
+	// Re-apply the changes the operation stashed, then drop the entry.
+	// The entry is addressed by commit, as stashing local changes above shifted its index.
+	if stashRef != "" {
+		if _, err := c.executor.RunOutput(ctx, repo.Path, "stash", "apply", undo.Stash); err != nil {
+			logger.Warn("failed to re-apply stashed changes", "path", repo.RelativePath, "stash", undo.Stash)
+			message += "; stashed changes could not be applied and are kept in the stash"
+		} else {
+			if ref, err := c.findStash(ctx, repo.Path, undo.Stash); err == nil && ref != "" {
+				_, _ = c.executor.Run(ctx, repo.Path, "stash", "drop", "-q", ref)
+			}
+			message += " with stashed changes"
+		}
+	}
+
+	if stashedLocal {
+		if _, err := c.executor.RunOutput(ctx, repo.Path, "stash", "pop"); err != nil {
+			logger.Warn("failed to pop local changes", "path", repo.RelativePath)
+			message += "; local changes are kept in the stash (" + StashMessage(StashCreatorUndo, "") + ")"
+		}
+	}
+
+	logger.Info("repository restored", "path", repo.RelativePath, "branch", undo.Branch, "head", undo.Head)
+
+	return finish(StatusRestored, message, nil)
+}
+
+// restoreHead checks out the recorded branch and resets it to the recorded commit,
+// or detaches HEAD at the recorded commit if it was detached.
+func (c *client) restoreHead(ctx context.Context, repoPath, currentBranch string, undo *UndoState) error {
+	if undo.Branch == "" {
+		_, err := c.executor.RunOutput(ctx, repoPath, "checkout", "-q", undo.Head)
+		return err
+	}
+
+	if currentBranch != undo.Branch {
+		if _, err := c.executor.RunOutput(ctx, repoPath, "checkout", "-q", undo.Branch); err != nil {
+			return err
+		}
+	}
+	_, err := c.executor.RunOutput(ctx, repoPath, "reset", "-q", "--hard", undo.Head)
+	return err
+}
+
+// headState returns the checked-out branch (empty if HEAD is detached) and the HEAD commit.
+func (c *client) headState(ctx context.Context, repoPath string) (branch, head string, err error) {
+	head, err = c.executor.RunOutput(ctx, repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		return "", "", err
+	}
+	branch, err = c.executor.RunOutput(ctx, repoPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", "", err
+	}
+	if branch == "HEAD" {
+		branch = ""
+	}
+	return branch, head, nil
+}
+
+// captureUndoState records the state of a repository before an operation changes it.
+// Returns nil if there is nothing to restore, e.g. in a repository without commits.
+func (c *client) captureUndoState(ctx context.Context, repoPath string) *UndoState {
+	branch, head, err := c.headState(ctx, repoPath)
+	if err != nil {
+		return nil
+	}
+	return &UndoState{Branch: branch, Head: head}
+}
+
+// completeUndoState records the state of a repository after the operation.
+func (c *client) completeUndoState(ctx context.Context, repoPath string, state *UndoState) {
+	if state == nil {
+		return
+	}
+	state.AfterBranch, state.AfterHead, _ = c.headState(ctx, repoPath)
+}
+
+// latestStash returns the commit of the newest stash entry, or empty if there is none.
+func (c *client) latestStash(ctx context.Context, repoPath string) string {
+	commit, err := c.executor.RunOutput(ctx, repoPath, "rev-parse", "--verify", "-q", "refs/stash")
+	if err != nil {
+		return ""
+	}
+	return commit
+}
+
+// findStash returns the reference (e.g. "stash@{2}") of the stash entry with the
+// given commit, or empty if the commit is empty or no longer in the stash list.
+func (c *client) findStash(ctx context.Context, repoPath, commit string) (string, error) {
+	if commit == "" {
+		return "", nil
+	}
+
+	lines, err := c.executor.RunLines(ctx, repoPath, "stash", "list", "--format=%gd %H")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range lines {
+		if ref, hash, ok := strings.Cut(line, " "); ok && hash == commit {
+			return ref, nil
+		}
+	}
+	return "", nil
+}
+
+// describeHead describes a branch and commit for messages, e.g. "main at 1a2b3c4".
+func describeHead(branch, head string) string {
+	short := ShortCommit(head)
+	if branch == "" {
+		return "detached HEAD at " + short
+	}
+	return branch + " at " + short
+}
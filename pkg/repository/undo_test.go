@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gitOutput runs git in dir and returns its trimmed output
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// initSwitchRepo creates a repository at path with an extra commit on branch feature
+func initSwitchRepo(t *testing.T, path string) (defaultBranch string) {
+	t.Helper()
+
+	initTagRepo(t, path, "")
+	defaultBranch = gitOutput(t, path, "rev-parse", "--abbrev-ref", "HEAD")
+
+	gitOutput(t, path, "checkout", "-q", "-b", "feature")
+	if err := os.WriteFile(filepath.Join(path, "feature.txt"), []byte("feature\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	gitOutput(t, path, "add", ".")
+	gitOutput(t, path, "commit", "-q", "-m", "Add feature")
+	gitOutput(t, path, "checkout", "-q", defaultBranch)
+
+	return defaultBranch
+}
+
+func TestUndoSwitch(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "app")
+	defaultBranch := initSwitchRepo(t, repoPath)
+	before := gitOutput(t, repoPath, "rev-parse", "HEAD")
+
+	client := NewClient()
+	switchResult, err := client.BulkSwitch(ctx, BulkSwitchOptions{Directory: tmpDir, Branch: "feature"})
+	if err != nil {
+		t.Fatalf("BulkSwitch failed: %v", err)
+	}
+	if got := gitOutput(t, repoPath, "rev-parse", "--abbrev-ref", "HEAD"); got != "feature" {
+		t.Fatalf("expected to be on feature after switch, got %s", got)
+	}
+
+	// The undo state survives a round trip through the journal
+	run, err := NewRun(tmpDir, switchResult.Report())
+	if err != nil {
+		t.Fatalf("NewRun failed: %v", err)
+	}
+	journal, err := NewRunJournal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewRunJournal failed: %v", err)
+	}
+	if err := journal.Save(run); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	run, err = journal.LastUndoable()
+	if err != nil {
+		t.Fatalf("LastUndoable failed: %v", err)
+	}
+	undo := run.Repositories[0].Undo
+	if undo == nil || undo.Branch != defaultBranch || undo.Head != before || undo.AfterBranch != "feature" {
+		t.Fatalf("unexpected undo state: %+v", undo)
+	}
+
+	// A dry run changes nothing
+	result, err := client.Undo(ctx, UndoOptions{Run: run, DryRun: true})
+	if err != nil {
+		t.Fatalf("Undo dry run failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusWouldRestore {
+		t.Errorf("dry run status = %s, want %s", got, StatusWouldRestore)
+	}
+
+	result, err = client.Undo(ctx, UndoOptions{Run: run})
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusRestored {
+		t.Fatalf("status = %s (%s), want %s", got, result.Repositories[0].Message, StatusRestored)
+	}
+	if got := gitOutput(t, repoPath, "rev-parse", "--abbrev-ref", "HEAD"); got != defaultBranch {
+		t.Errorf("branch after undo = %s, want %s", got, defaultBranch)
+	}
+
+	// Undoing again has nothing left to do
+	result, err = client.Undo(ctx, UndoOptions{Run: run})
+	if err != nil {
+		t.Fatalf("second Undo failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusSkipped {
+		t.Errorf("second undo status = %s, want %s", got, StatusSkipped)
+	}
+}
+
+func TestUndoSkipsChangedRepository(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "app")
+	defaultBranch := initSwitchRepo(t, repoPath)
+
+	client := NewClient()
+	switchResult, err := client.BulkSwitch(ctx, BulkSwitchOptions{Directory: tmpDir, Branch: "feature"})
+	if err != nil {
+		t.Fatalf("BulkSwitch failed: %v", err)
+	}
+	run, err := NewRun(tmpDir, switchResult.Report())
+	if err != nil {
+		t.Fatalf("NewRun failed: %v", err)
+	}
+
+	// Work continues on the switched branch after the run
+	gitOutput(t, repoPath, "commit", "-q", "--allow-empty", "-m", "More work")
+
+	result, err := client.Undo(ctx, UndoOptions{Run: run})
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusSkipped {
+		t.Fatalf("status = %s, want %s for a repository that changed since the run", got, StatusSkipped)
+	}
+	if got := gitOutput(t, repoPath, "rev-parse", "--abbrev-ref", "HEAD"); got != "feature" {
+		t.Errorf("skipped repository was changed: on %s", got)
+	}
+
+	result, err = client.Undo(ctx, UndoOptions{Run: run, Force: true})
+	if err != nil {
+		t.Fatalf("forced Undo failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusRestored {
+		t.Errorf("forced status = %s (%s), want %s", got, result.Repositories[0].Message, StatusRestored)
+	}
+	if got := gitOutput(t, repoPath, "rev-parse", "--abbrev-ref", "HEAD"); got != defaultBranch {
+		t.Errorf("branch after forced undo = %s, want %s", got, defaultBranch)
+	}
+}
+
+func TestUndoReset(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	seed := filepath.Join(tmpDir, "seed")
+	remote := filepath.Join(tmpDir, "remote.git")
+	clonePath := filepath.Join(tmpDir, "clone")
+
+	initTagRepo(t, seed, remote)
+	gitOutput(t, seed, "push", "-q", "origin", "HEAD")
+	gitOutput(t, tmpDir, "clone", "-q", remote, clonePath)
+	gitOutput(t, clonePath, "config", "user.name", "Test User")
+	gitOutput(t, clonePath, "config", "user.email", "test@example.com")
+
+	// A local commit and an uncommitted change, both discarded by the reset
+	gitOutput(t, clonePath, "commit", "-q", "--allow-empty", "-m", "Local work")
+	localHead := gitOutput(t, clonePath, "rev-parse", "HEAD")
+	readme := filepath.Join(clonePath, "README.md")
+	if err := os.WriteFile(readme, []byte("local change\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	client := NewClient()
+	updateResult, err := client.CloneOrUpdate(ctx, CloneOrUpdateOptions{
+		URL:         remote,
+		Destination: clonePath,
+		Strategy:    StrategyReset,
+	})
+	if err != nil {
+		t.Fatalf("CloneOrUpdate failed: %v", err)
+	}
+	if updateResult.Undo == nil || updateResult.Undo.Head != localHead || updateResult.Undo.Stash == "" {
+		t.Fatalf("unexpected undo state: %+v", updateResult.Undo)
+	}
+	if got := gitOutput(t, clonePath, "rev-parse", "HEAD"); got == localHead {
+		t.Fatal("reset did not move HEAD")
+	}
+
+	run, err := NewRepositoryRun("update", clonePath, StatusReset, updateResult.Message, updateResult.Undo)
+	if err != nil {
+		t.Fatalf("NewRepositoryRun failed: %v", err)
+	}
+
+	result, err := client.Undo(ctx, UndoOptions{Run: run})
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if got := result.Repositories[0].Status; got != StatusRestored {
+		t.Fatalf("status = %s (%s), want %s", got, result.Repositories[0].Message, StatusRestored)
+	}
+	if got := gitOutput(t, clonePath, "rev-parse", "HEAD"); got != localHead {
+		t.Errorf("HEAD after undo = %s, want %s", got, localHead)
+	}
+	if data, _ := os.ReadFile(readme); string(data) != "local change\n" {
+		t.Errorf("local change not restored, README.md = %q", data)
+	}
+	if stashes := gitOutput(t, clonePath, "stash", "list"); stashes != "" {
+		t.Errorf("stash entry should be dropped after undo, got %q", stashes)
+	}
+}
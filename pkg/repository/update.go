@@ -46,6 +46,11 @@ type CloneOrUpdateOptions struct {
 	// 0 means full clone, 1 means shallow clone with only the latest commit
 	Depth int
 
+	// SparsePaths enables a cone-mode sparse checkout limited to these directories.
+	// When an existing repository is updated with the rebase, pull or reset strategy,
+	// its sparse set is changed to match; empty leaves the working tree as it is
+	SparsePaths []string
+
 	// Force allows destructive operations even when not normally allowed
 	Force bool
 
@@ -77,6 +82,9 @@ type CloneOrUpdateResult struct {
 
 	// Message contains a human-readable result message
 	Message string
+
+	// Undo is the state before a reset with StrategyReset, for undoing it
+	Undo *UndoState
 }
 
 // CloneOrUpdate clones a repository if it doesn't exist, or updates it using the specified strategy.
@@ -126,6 +134,12 @@ func (c *client) CloneOrUpdate(ctx context.Context, opts CloneOrUpdateOptions) (
 		}
 	}
 
+	sparsePaths, err := normalizeSparsePaths(opts.SparsePaths)
+	if err != nil {
+		return nil, err
+	}
+	opts.SparsePaths = sparsePaths
+
 	// Use provided logger or noop
 	logger := opts.Logger
 	if logger == nil {
@@ -170,7 +184,14 @@ func (c *client) CloneOrUpdate(ctx context.Context, opts CloneOrUpdateOptions) (
 	case exists && isGitRepo:
 		// Directory exists and is a git repo - apply update strategy
 		logger.Info("applying update strategy to existing repository", "strategy", opts.Strategy)
-		return c.applyUpdateStrategy(ctx, opts, logger)
+		result, err := c.applyUpdateStrategy(ctx, opts, logger)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.applySparsePaths(ctx, opts, logger, result); err != nil {
+			return nil, err
+		}
+		return result, nil
 
 	default:
 		return nil, fmt.Errorf("unexpected state in target directory analysis")
@@ -184,6 +205,7 @@ func (c *client) performCloneOperation(ctx context.Context, opts CloneOrUpdateOp
 		Destination:  opts.Destination,
 		Branch:       opts.Branch,
 		Depth:        opts.Depth,
+		SparsePaths:  opts.SparsePaths,
 		CreateBranch: opts.CreateBranch,
 		Logger:       logger,
 		Progress:     opts.Progress,
@@ -245,6 +267,29 @@ func (c *client) applyUpdateStrategy(ctx context.Context, opts CloneOrUpdateOpti
 	}
 }
 
+// applySparsePaths keeps the sparse checkout of an updated repository in sync with
+// opts.SparsePaths. Strategies that leave the working tree alone (skip, fetch) and
+// the clone strategy, whose fresh clone already uses the sparse set, are ignored.
+func (c *client) applySparsePaths(ctx context.Context, opts CloneOrUpdateOptions, logger Logger, result *CloneOrUpdateResult) error {
+	if len(opts.SparsePaths) == 0 {
+		return nil
+	}
+	switch result.StrategyUsed {
+	case StrategyRebase, StrategyPull, StrategyReset:
+	default:
+		return nil
+	}
+
+	changed, err := c.syncSparseCheckout(ctx, opts.Destination, opts.SparsePaths, logger)
+	if err != nil {
+		return fmt.Errorf("failed to update sparse checkout: %w", err)
+	}
+	if changed {
+		result.Message += fmt.Sprintf(" (sparse checkout: %s)", strings.Join(opts.SparsePaths, ", "))
+	}
+	return nil
+}
+
 // applyFetchStrategy fetches remote changes without updating working directory
 func (c *client) applyFetchStrategy(ctx context.Context, opts CloneOrUpdateOptions, logger Logger) (*CloneOrUpdateResult, error) {
 	args := []string{"fetch", "origin"}
@@ -320,6 +365,19 @@ func (c *client) applyResetStrategy(ctx context.Context, opts CloneOrUpdateOptio
 		resetTarget = fmt.Sprintf("origin/%s", opts.Branch)
 	}
 
+	// Record the state before the reset so it can be undone, keeping local
+	// changes in a labelled stash entry instead of discarding them
+	undo := c.captureUndoState(ctx, opts.Destination)
+	if undo != nil {
+		if stash, err := c.executor.RunOutput(ctx, opts.Destination, "stash", "create"); err == nil && stash != "" {
+			if _, err := c.executor.RunOutput(ctx, opts.Destination, "stash", "store", "-m", StashMessage(StashCreatorReset, "before reset"), stash); err != nil {
+				logger.Warn("failed to save local changes before reset", "path", opts.Destination, "error", err)
+			} else {
+				undo.Stash = stash
+			}
+		}
+	}
+
 	// Hard reset to remote
 	resetResult, err := c.executor.Run(ctx, opts.Destination, "reset", "--hard", resetTarget)
 	if err != nil {
@@ -328,6 +386,7 @@ func (c *client) applyResetStrategy(ctx context.Context, opts CloneOrUpdateOptio
 	if resetResult.ExitCode != 0 {
 		return nil, fmt.Errorf("reset failed: %s", resetResult.Error)
 	}
+	c.completeUndoState(ctx, opts.Destination, undo)
 
 	repo, err := c.Open(ctx, opts.Destination)
 	if err != nil {
@@ -340,6 +399,7 @@ func (c *client) applyResetStrategy(ctx context.Context, opts CloneOrUpdateOptio
 		StrategyUsed: StrategyReset,
 		Success:      true,
 		Message:      fmt.Sprintf("Successfully reset %s to %s", opts.Destination, resetTarget),
+		Undo:         undo,
 	}, nil
 }
 